@@ -0,0 +1,10 @@
+data "squadcast_maintenance_calendar" "next_month" {
+  service_ids = ["service id"]
+  from        = "2024-06-01T00:00:00Z"
+  till        = "2024-07-01T00:00:00Z"
+}
+
+resource "local_file" "maintenance_calendar" {
+  filename = "${path.module}/maintenance.ics"
+  content  = data.squadcast_maintenance_calendar.next_month.ics
+}
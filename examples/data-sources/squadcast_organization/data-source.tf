@@ -0,0 +1,8 @@
+data "squadcast_organization" "current" {}
+
+check "organization" {
+  assert {
+    condition     = data.squadcast_organization.current.slug == "acme-production"
+    error_message = "This configuration must be applied to the acme-production organization."
+  }
+}
@@ -0,0 +1,24 @@
+data "squadcast_status_page_statuses" "example_status_page_statuses" {
+  status_page_id = "1234"
+}
+
+resource "squadcast_workflow_action" "example_status_page_update" {
+  workflow_id = "workflow id"
+  name        = "sq_add_status_page_issue"
+
+  status_page_update {
+    status_page_id = 1234
+    issue_title    = "Degraded performance"
+    page_status_id = data.squadcast_status_page_statuses.example_status_page_statuses.statuses["Investigating"]
+
+    component_and_impact {
+      component_id     = 5678
+      impact_status_id = data.squadcast_status_page_statuses.example_status_page_statuses.impacts["Partial Outage"]
+    }
+
+    status_and_message {
+      status_id = data.squadcast_status_page_statuses.example_status_page_statuses.statuses["Investigating"]
+      messages  = ["We are investigating the degraded performance."]
+    }
+  }
+}
@@ -0,0 +1,4 @@
+data "squadcast_webhook" "example_webhook" {
+  name    = "open a ticket"
+  team_id = "team id"
+}
@@ -0,0 +1,8 @@
+ephemeral "squadcast_access_token" "this" {}
+
+provider "restapi" {
+  uri = "https://api.squadcast.com"
+  headers = {
+    Authorization = "Bearer ${ephemeral.squadcast_access_token.this.access_token}"
+  }
+}
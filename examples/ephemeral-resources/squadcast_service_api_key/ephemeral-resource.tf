@@ -0,0 +1,20 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_service" "example_service" {
+  name    = "example service name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+ephemeral "squadcast_service_api_key" "example_service" {
+  service_id = data.squadcast_service.example_service.id
+  team_id    = data.squadcast_team.example_team.id
+}
+
+# The webhook URL is handed to a write-only attribute and never written to the state.
+resource "aws_secretsmanager_secret_version" "datadog_webhook" {
+  secret_id                = "squadcast-datadog-webhook"
+  secret_string_wo         = ephemeral.squadcast_service_api_key.example_service.active_alert_source_endpoints["datadog"]
+  secret_string_wo_version = 1
+}
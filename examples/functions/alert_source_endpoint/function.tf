@@ -0,0 +1,11 @@
+resource "squadcast_service" "example" {
+  name                 = "example service"
+  team_id              = "team id"
+  escalation_policy_id = "escalation policy id"
+  email_prefix         = "example-service"
+}
+
+output "prometheus_webhook_url" {
+  value     = provider::squadcast::alert_source_endpoint("v2", "prometheus", squadcast_service.example.api_key, "us")
+  sensitive = true
+}
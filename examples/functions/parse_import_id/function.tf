@@ -0,0 +1,8 @@
+locals {
+  rotation_import_id = "613611c1eb22db455cfa789f:Primary:Nights"
+  rotation_parts     = provider::squadcast::parse_import_id(local.rotation_import_id, 3)
+}
+
+output "schedule_name" {
+  value = local.rotation_parts[1]
+}
@@ -0,0 +1,23 @@
+resource "squadcast_schedule_rotation_v2" "nights" {
+  schedule_id = 100
+  name        = "Nights"
+  start_date  = "2024-06-03T00:00:00Z"
+  period      = "weekly"
+  shift_timeslots {
+    start_hour   = 22
+    start_minute = 0
+    duration     = 600
+  }
+  participant_groups {
+    participants {
+      type = "user"
+      id   = "user id"
+    }
+  }
+  change_participants_frequency = 1
+  change_participants_unit      = "rotation"
+}
+
+output "next_week" {
+  value = provider::squadcast::rotation_shifts(squadcast_schedule_rotation_v2.nights, "Asia/Kolkata", "2024-06-10T00:00:00+05:30", "2024-06-17T00:00:00+05:30")
+}
@@ -0,0 +1,3 @@
+output "service_email" {
+  value = provider::squadcast::service_email("example-service", "us", "example-org")
+}
@@ -0,0 +1,7 @@
+list "squadcast_escalation_policy" "example" {
+  provider = squadcast
+
+  config {
+    team_id = "team id"
+  }
+}
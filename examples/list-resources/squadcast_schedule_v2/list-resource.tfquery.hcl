@@ -0,0 +1,8 @@
+list "squadcast_schedule_v2" "example" {
+  provider = squadcast
+
+  config {
+    team_id = "team id"
+    name    = "Primary on-call"
+  }
+}
@@ -0,0 +1,12 @@
+# Lists the services of a team whose name contains "payments", with their attributes.
+# Run `terraform query -generate-config-out=services.tf` to generate their configuration
+# and import blocks.
+list "squadcast_service" "payments" {
+  provider         = squadcast
+  include_resource = true
+
+  config {
+    team_id = "team id"
+    name    = "payments"
+  }
+}
@@ -0,0 +1,7 @@
+list "squadcast_squad" "example" {
+  provider = squadcast
+
+  config {
+    team_id = "team id"
+  }
+}
@@ -0,0 +1,8 @@
+list "squadcast_webform" "example" {
+  provider = squadcast
+
+  config {
+    team_id = "team id"
+    name    = "Status page requests"
+  }
+}
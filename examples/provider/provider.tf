@@ -3,4 +3,18 @@ provider "squadcast" {
   # refresh_token and region can also be passed via environment variables (SQUADCAST_REFRESH_TOKEN and SQUADCAST_REGION)
   refresh_token = "YOUR-SQUADCAST-TOKEN"
   region        = "us"
+
+  # Used by resources and data sources which do not set team_id, can also be passed via SQUADCAST_TEAM_ID
+  default_team_id = "YOUR-TEAM-ID"
+
+  # Fail instead of managing another organization, e.g. when the token of another environment is used
+  allowed_organization_slugs = ["YOUR-ORGANIZATION-SLUG"]
+
+  # Merged into the tags of services, schedules, webforms, SLOs and workflows, tags set on a resource take precedence
+  default_tags {
+    tags = {
+      managed_by = "terraform"
+      env        = "production"
+    }
+  }
 }
@@ -0,0 +1,37 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_service" "example_api_service" {
+  name = "example api service"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_service" "example_db_service" {
+  name = "example db service"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_maintenance_window" "example_database_upgrade" {
+  service_ids = [
+    data.squadcast_service.example_api_service.id,
+    data.squadcast_service.example_db_service.id,
+  ]
+
+  from = "2032-06-01T10:30:00Z"
+  till = "2032-06-01T11:30:00Z"
+}
+
+resource "squadcast_maintenance_window" "example_weekly_patching" {
+  service_selector {
+    team_id = data.squadcast_team.example_team.id
+    tags = {
+      env = "production"
+    }
+  }
+
+  from             = "2032-06-01T22:00:00Z"
+  till             = "2032-06-01T23:00:00Z"
+  repeat_till      = "2032-12-31T22:00:00Z"
+  repeat_frequency = "week"
+}
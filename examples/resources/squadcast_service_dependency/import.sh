@@ -0,0 +1,2 @@
+# teamID:serviceID:dependsOnID
+terraform import squadcast_service_dependency.api_depends_on_db "62d2fe23a57381088224d726:62da76c088f407f9ca756ca5:62da76c088f407f9ca756ca6"
\ No newline at end of file
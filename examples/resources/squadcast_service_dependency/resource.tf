@@ -0,0 +1,26 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_service" "example_api_service" {
+  name = "example api service"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_service" "example_db_service" {
+  name = "example db service"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_service_dependency" "api_depends_on_db" {
+  team_id       = data.squadcast_team.example_team.id
+  service_id    = data.squadcast_service.example_api_service.id
+  depends_on_id = data.squadcast_service.example_db_service.id
+}
+
+# services may depend on each other
+resource "squadcast_service_dependency" "db_depends_on_api" {
+  team_id       = data.squadcast_team.example_team.id
+  service_id    = data.squadcast_service.example_db_service.id
+  depends_on_id = data.squadcast_service.example_api_service.id
+}
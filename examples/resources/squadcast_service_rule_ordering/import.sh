@@ -0,0 +1,2 @@
+# teamID:serviceID:ruleType
+terraform import squadcast_service_rule_ordering.example_routing_rule_ordering "62d2fe23a57381088224d726:62da76c088f407f9ca756ca5:routing"
\ No newline at end of file
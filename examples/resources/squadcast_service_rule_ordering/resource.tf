@@ -0,0 +1,48 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_user" "example_user" {
+  email = "test@example.com"
+}
+
+data "squadcast_service" "example_service" {
+  name = "example service name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_escalation_policy" "example_escalaion_policy" {
+  name = "example escalation policy name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_routing_rule_v2" "example_routing_rule_1" {
+    service_id = data.squadcast_service.example_service.id
+    is_basic   = false
+    expression = "payload[\"event_id\"] == 40"
+
+    route_to_id   = data.squadcast_user.example_user.id
+    route_to_type = "user"
+}
+
+resource "squadcast_routing_rule_v2" "example_routing_rule_2" {
+    service_id = data.squadcast_service.example_service.id
+    is_basic   = true
+    basic_expressions {
+      lhs = "payload[\"foo\"]"
+      rhs = "bar"
+    }
+
+    route_to_id   = data.squadcast_escalation_policy.example_escalaion_policy.id
+    route_to_type = "escalationpolicy"
+}
+
+resource "squadcast_service_rule_ordering" "example_routing_rule_ordering" {
+    team_id    = data.squadcast_team.example_team.id
+    service_id = data.squadcast_service.example_service.id
+    rule_type  = "routing"
+    ordering = [
+        squadcast_routing_rule_v2.example_routing_rule_2.id,
+        squadcast_routing_rule_v2.example_routing_rule_1.id,
+    ]
+}
@@ -34,3 +34,19 @@ resource "squadcast_status_page_component" "example_component" {
 	description = "Component 1 description"
 	group_id = squadcast_status_page_group.example_group.id
 }
+
+data "squadcast_service" "api" {
+  name    = "API"
+  team_id = data.squadcast_team.team.id
+}
+
+data "squadcast_status_page_statuses" "statuses" {
+  status_page_id = squadcast_status_page.test_status_page.id
+}
+
+resource "squadcast_status_page_component" "example_linked_component" {
+  status_page_id   = squadcast_status_page.test_status_page.id
+  name             = "API"
+  service_ids      = [data.squadcast_service.api.id]
+  impact_status_id = data.squadcast_status_page_statuses.statuses.impacts["Partial Outage"]
+}
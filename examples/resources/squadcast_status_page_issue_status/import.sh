@@ -0,0 +1,2 @@
+# statusPageID:issueStatusID
+terraform import squadcast_status_page_issue_status.example_issue_status 300:14
@@ -0,0 +1,5 @@
+resource "squadcast_status_page_issue_status" "example_issue_status" {
+  status_page_id = "status page id"
+  name           = "Monitoring the fix"
+  color          = "#f2994a"
+}
@@ -0,0 +1,2 @@
+# statusPageID:maintenanceID
+terraform import squadcast_status_page_maintenance.example_maintenance 300:27
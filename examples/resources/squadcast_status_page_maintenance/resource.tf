@@ -0,0 +1,18 @@
+data "squadcast_status_page_statuses" "statuses" {
+  status_page_id = "status page id"
+}
+
+resource "squadcast_status_page_maintenance" "example_maintenance" {
+  status_page_id = "status page id"
+  title          = "Database upgrade"
+  description    = "The dashboard is read-only while the database is upgraded."
+  start_time     = "2032-01-10T02:00:00Z"
+  end_time       = "2032-01-10T04:00:00Z"
+
+  components {
+    component_id     = "component id"
+    impact_status_id = data.squadcast_status_page_statuses.statuses.impacts["Under Maintenance"]
+  }
+
+  notify_subscribers = true
+}
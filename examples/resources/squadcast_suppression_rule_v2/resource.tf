@@ -91,3 +91,17 @@ resource "squadcast_suppression_rule_v2" "example_time_based_suppression_rules_c
     }
   }
 }
+
+resource "squadcast_suppression_rule_v2" "example_time_based_suppression_rules_rrule" {
+  service_id = data.squadcast_service.example_service.id
+  is_basic    = false
+  description = "suppress on weekends"
+  expression  = "payload[\"event_id\"] == 40"
+  # instead of repetition, custom, ends_never and ends_on
+  timeslots {
+    time_zone = "Asia/Calcutta"
+    rrule     = "FREQ=WEEKLY;BYDAY=SA,SU;UNTIL=20321231T000000Z"
+    dtstart   = "2032-06-05T00:00:00Z"
+    duration  = "24h"
+  }
+}
@@ -0,0 +1,3 @@
+# teamID:webhookName
+# Use 'Get All Teams' API to get the id of the team
+terraform import squadcast_webhook.example_webhook 62d2fe23a57381088224d726:"incident events"
@@ -0,0 +1,39 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+resource "squadcast_webhook" "example_webhook" {
+  name    = "incident events"
+  team_id = data.squadcast_team.example_team.id
+  url     = "https://example.com/squadcast/events"
+
+  triggers {
+    event_class = "incident"
+    event_type  = "triggered"
+  }
+  triggers {
+    event_class = "incident"
+    event_type  = "resolved"
+  }
+
+  headers {
+    key   = "Authorization"
+    value = var.webhook_token
+  }
+}
+
+resource "squadcast_webhook" "example_manual_webhook" {
+  name             = "open a ticket"
+  team_id          = data.squadcast_team.example_team.id
+  type             = "manual"
+  url              = "https://example.com/tickets"
+  payload_template = "{\"incident_id\": \"{{incident.id}}\", \"message\": \"{{incident.message}}\"}"
+}
+
+resource "squadcast_workflow_action" "example_workflow_action" {
+  workflow_id = squadcast_workflow.example_workflow.id
+  name        = "sq_trigger_manual_webhook"
+  trigger_webhook {
+    webhook_id = squadcast_webhook.example_manual_webhook.id
+  }
+}
@@ -0,0 +1,3 @@
+# workflowID
+# Use 'Get All Workflows' API to get the id of the workflow
+terraform import squadcast_workflow.example_workflow 1234
@@ -113,4 +113,44 @@ resource "squadcast_workflow" "example_workflow_with_advanced_filters" {
       value = "tagValue"
       color = "#000000"
    }
-}
\ No newline at end of file
+}
+
+resource "squadcast_workflow" "example_workflow_with_nested_filters" {
+   title = "test workflow"
+   description = "Test workflow description"
+   owner_id = data.squadcast_team.example_team.id
+   enabled = true
+   trigger = "incident_triggered"
+   # priority is P1 and (service is example_service or (tag env is prod and tag team is payments))
+   filters {
+      condition = "and"
+      filters {
+         type = "priority_is"
+         value = "P1"
+      }
+      filters {
+         condition = "or"
+         filters {
+            type = "service_is"
+            value = data.squadcast_service.example_service.id
+         }
+         filters {
+            condition = "and"
+            filters {
+               type = "tag_is"
+               key = "env"
+               value = "prod"
+            }
+            filters {
+               type = "tag_is"
+               key = "team"
+               value = "payments"
+            }
+         }
+      }
+   }
+   entity_owner {
+      type = "user" 
+      id = data.squadcast_user.example_user.id
+   }
+}
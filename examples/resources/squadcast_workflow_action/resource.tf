@@ -31,10 +31,12 @@ resource "squadcast_workflow" "example_workflow" {
 }
 
 resource "squadcast_workflow_action" "example_workflow" {
-  workflow_id  = squadcast_workflow.example_workflow.id
-  name         = "slack_create_incident_channel"
-  auto_name    = false
-  channel_name = "enter-channel-name"
+  workflow_id = squadcast_workflow.example_workflow.id
+  name        = "slack_create_incident_channel"
+  slack_create_channel {
+    auto_name    = false
+    channel_name = "enter-channel-name"
+  }
 }
 
 resource "squadcast_workflow_action" "example_workflow" {
@@ -45,61 +47,77 @@ resource "squadcast_workflow_action" "example_workflow" {
 resource "squadcast_workflow_action" "example_workflow" {
   workflow_id = squadcast_workflow.example_workflow.id
   name        = "slack_message_channel"
-  channel_id  = "C06P4473BJA"
-  message     = "test incident created..."
+  slack_message_channel {
+    channel_id = "C06P4473BJA"
+    message    = "test incident created..."
+  }
 }
 
 resource "squadcast_workflow_action" "example_workflow" {
   workflow_id = squadcast_workflow.example_workflow.id
   name        = "sq_trigger_manual_webhook"
-  webhook_id  = "660edb863a1cefa8f291aebe"
+  trigger_webhook {
+    webhook_id = "660edb863a1cefa8f291aebe"
+  }
 }
 
 resource "squadcast_workflow_action" "example_workflow" {
   workflow_id = squadcast_workflow.example_workflow.id
   name        = "sq_send_email"
-  to          = ["abc@squadcast.com", "xyz@squadcast.com"]
-  subject     = "enter your subject here"
-  body        = "enter your body here"
+  send_email {
+    to      = ["abc@squadcast.com", "xyz@squadcast.com"]
+    subject = "enter your subject here"
+    body    = "enter your body here"
+  }
 }
 
 resource "squadcast_workflow_action" "example_workflow" {
   workflow_id = squadcast_workflow.example_workflow.id
   name        = "sq_make_http_call"
-  url         = "https://httpbin.org/post"
-  method      = "GET"
-  headers {
-    key   = "content-type"
-    value = "application/json"
+  http_call {
+    url    = "https://httpbin.org/post"
+    method = "GET"
+    headers {
+      key   = "content-type"
+      value = "application/json"
+    }
+    body = "{\"key\":\"value\"}"
   }
-  body = "{\"key\":\"value\"}"
 }
 
 resource "squadcast_workflow_action" "example_workflow" {
   workflow_id = squadcast_workflow.example_workflow.id
   name        = "sq_update_incident_priority"
-  priority    = "P2"
+  update_priority {
+    priority = "P2"
+  }
 }
 
 resource "squadcast_workflow_action" "example_workflow" {
   workflow_id = squadcast_workflow.example_workflow.id
   name        = "sq_add_communication_channel"
-  channels {
-    type         = "chat_room"
-    link         = "https://chat.squadcast.com/room/123456"
-    display_text = "enter your display text here"
+  add_communication_channel {
+    channels {
+      type         = "chat_room"
+      link         = "https://chat.squadcast.com/room/123456"
+      display_text = "enter your display text here"
+    }
   }
 }
 
 resource "squadcast_workflow_action" "example_workflow" {
   workflow_id = squadcast_workflow.example_workflow.id
   name        = "sq_mark_incident_slo_affecting"
-  slo         = "2119"
-  slis        = ["errors"]
+  mark_slo_affecting {
+    slo  = 2119
+    slis = ["errors"]
+  }
 }
 
 resource "squadcast_workflow_action" "example_workflow" {
   workflow_id = squadcast_workflow.example_workflow.id
   name        = "sq_attach_runbooks"
-  runbooks    = ["660ced558d1d4df4a61823ee", "660d46f62f8acc7786618202"]
+  attach_runbooks {
+    runbooks = ["660ced558d1d4df4a61823ee", "660d46f62f8acc7786618202"]
+  }
 }
@@ -64,13 +64,21 @@ func (alertSource *AlertSource) Endpoint(ingestionBaseURL string, service *Servi
 		return service.APIKey
 	}
 
-	return fmt.Sprintf("%s/%s/incidents/%s/%s", ingestionBaseURL, alertSource.Version, alertSource.ShortName, service.APIKey)
+	return AlertSourceURL(ingestionBaseURL, alertSource.Version, alertSource.ShortName, service.APIKey)
 }
 
+// AlertSourceURL returns the ingestion URL of an alert source for a service api key.
+func AlertSourceURL(ingestionBaseURL, version, shortName, apiKey string) string {
+	return fmt.Sprintf("%s/%s/incidents/%s/%s", ingestionBaseURL, version, shortName, apiKey)
+}
+
+// ListAlertSources lists the alert sources of the catalog. The list is cached by the client.
 func (client *Client) ListAlertSources(ctx context.Context) (AlertSourcesList, error) {
 	url := fmt.Sprintf("%s/alert-sources", client.BaseURLV3)
 
-	return RequestSlice[any, AlertSource](http.MethodGet, url, client, ctx, nil)
+	return cached(ctx, &client.catalog, "alert-sources", func(ctx context.Context) (AlertSourcesList, error) {
+		return RequestSlice[any, AlertSource](http.MethodGet, url, client, ctx, nil)
+	})
 }
 
 func GetAlertSourceDetailsByName(client *Client, ctx context.Context, alertSourceName string) (*AlertSource, error) {
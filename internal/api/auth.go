@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 )
@@ -52,3 +53,52 @@ func (client *Client) GetAccessToken(ctx context.Context) (*AccessToken, error)
 
 	return &response.Data, nil
 }
+
+// Authenticate fetches an access token and the organization of the client, unless a previous call
+// succeeded. Every request authenticates first, so that credentials are only needed once the
+// provider makes a request.
+func (client *Client) Authenticate(ctx context.Context) error {
+	client.authMu.Lock()
+	defer client.authMu.Unlock()
+
+	if client.authenticated {
+		return nil
+	}
+
+	// A credential process may provide an access token instead of a refresh token.
+	if client.AccessToken == "" {
+		if client.RefreshToken == "" {
+			if client.CredentialsError != nil {
+				return client.CredentialsError
+			}
+			return errors.New("refresh_token is required")
+		}
+
+		token, err := client.GetAccessToken(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to fetch the access token: %w", err)
+		}
+		client.AccessToken = token.AccessToken
+	}
+
+	org, err := client.getCurrentOrganization(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch the organization: %w", err)
+	}
+	if err := client.checkOrganization(org); err != nil {
+		return err
+	}
+	client.OrganizationID = org.ID
+
+	client.authenticated = true
+	return nil
+}
+
+// OrganizationIDFor returns the id of the organization of the client, authenticating first if no
+// request did so yet.
+func (client *Client) OrganizationIDFor(ctx context.Context) (string, error) {
+	if err := client.Authenticate(ctx); err != nil {
+		return "", err
+	}
+	return client.OrganizationID, nil
+}
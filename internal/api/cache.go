@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// catalogCacheTTL is how long the catalog endpoints, which rarely change, are cached by a client.
+const catalogCacheTTL = 5 * time.Minute
+
+// cache is a concurrency-safe read-through cache. Concurrent loads of the same key share a single
+// call, failed loads are not cached.
+type cache struct {
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	// done is closed once the entry is loaded.
+	done    chan struct{}
+	value   any
+	err     error
+	expires time.Time
+}
+
+// cached returns the value of key, loading it with load if it is not cached or has expired. The load
+// is shared by every caller waiting for it, so it is not cancelled with the context of the caller
+// that started it.
+func cached[T any](ctx context.Context, c *cache, key string, load func(ctx context.Context) (T, error)) (T, error) {
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = make(map[string]*cacheEntry)
+	}
+	entry, ok := c.entries[key]
+	if ok {
+		select {
+		case <-entry.done:
+			if time.Now().After(entry.expires) {
+				ok = false
+			}
+		default:
+		}
+	}
+	if !ok {
+		entry = &cacheEntry{done: make(chan struct{})}
+		c.entries[key] = entry
+		c.mu.Unlock()
+
+		go func() {
+			entry.value, entry.err = load(context.WithoutCancel(ctx))
+			entry.expires = time.Now().Add(catalogCacheTTL)
+			if entry.err != nil {
+				c.remove(key, entry)
+			}
+			close(entry.done)
+		}()
+	} else {
+		c.mu.Unlock()
+	}
+
+	select {
+	case <-entry.done:
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+
+	if entry.err != nil {
+		var zero T
+		return zero, entry.err
+	}
+
+	return entry.value.(T), nil
+}
+
+// invalidate removes the entries of keys, so that they are loaded again.
+func (c *cache) invalidate(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+}
+
+// remove removes the entry of key, unless it has been replaced.
+func (c *cache) remove(key string, entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries[key] == entry {
+		delete(c.entries, key)
+	}
+}
@@ -0,0 +1,151 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCachedSharesConcurrentLoads(t *testing.T) {
+	var c cache
+	var loads atomic.Int32
+	release := make(chan struct{})
+	load := func(ctx context.Context) (string, error) {
+		loads.Add(1)
+		<-release
+		return "value", nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]string, 10)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := cached(context.Background(), &c, "key", load)
+			if err != nil {
+				t.Error(err)
+			}
+			results[i] = value
+		}()
+	}
+	// Give the callers time to wait for the load before it finishes.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := loads.Load(); n != 1 {
+		t.Errorf("got %d loads, want 1", n)
+	}
+	for _, value := range results {
+		if value != "value" {
+			t.Errorf("got %q, want %q", value, "value")
+		}
+	}
+}
+
+func TestCachedExpires(t *testing.T) {
+	var c cache
+	var loads int
+	load := func(ctx context.Context) (int, error) {
+		loads++
+		return loads, nil
+	}
+
+	for range 2 {
+		if value, err := cached(context.Background(), &c, "key", load); err != nil || value != 1 {
+			t.Fatalf("got %d and error %v, want the cached value 1", value, err)
+		}
+	}
+
+	c.entries["key"].expires = time.Now().Add(-time.Second)
+	if value, err := cached(context.Background(), &c, "key", load); err != nil || value != 2 {
+		t.Fatalf("got %d and error %v, want the expired value to be loaded again", value, err)
+	}
+}
+
+func TestCachedDoesNotCacheFailures(t *testing.T) {
+	var c cache
+	fail := true
+	load := func(ctx context.Context) (string, error) {
+		if fail {
+			return "", errors.New("unavailable")
+		}
+		return "value", nil
+	}
+
+	if _, err := cached(context.Background(), &c, "key", load); err == nil || err.Error() != "unavailable" {
+		t.Fatalf("got error %v, want the error of the load", err)
+	}
+	if _, ok := c.entries["key"]; ok {
+		t.Fatal("the failed load is cached")
+	}
+
+	fail = false
+	if value, err := cached(context.Background(), &c, "key", load); err != nil || value != "value" {
+		t.Fatalf("got %q and error %v, want the value to be loaded again", value, err)
+	}
+}
+
+func TestCachedInvalidate(t *testing.T) {
+	var c cache
+	var loads int
+	load := func(ctx context.Context) (int, error) {
+		loads++
+		return loads, nil
+	}
+
+	if _, err := cached(context.Background(), &c, "key", load); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cached(context.Background(), &c, "other", load); err != nil {
+		t.Fatal(err)
+	}
+
+	c.invalidate("key")
+	if value, err := cached(context.Background(), &c, "key", load); err != nil || value != 3 {
+		t.Errorf("got %d and error %v, want the invalidated key to be loaded again", value, err)
+	}
+	if value, err := cached(context.Background(), &c, "other", load); err != nil || value != 2 {
+		t.Errorf("got %d and error %v, want the other key to stay cached", value, err)
+	}
+}
+
+func TestCachedLoadOutlivesCancelledCaller(t *testing.T) {
+	var c cache
+	release := make(chan struct{})
+	load := func(ctx context.Context) (string, error) {
+		<-release
+		return "value", ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, err := cached(ctx, &c, "key", load)
+		first <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	second := make(chan string)
+	go func() {
+		value, err := cached(context.Background(), &c, "key", load)
+		if err != nil {
+			t.Error(err)
+		}
+		second <- value
+	}()
+
+	cancel()
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v for the cancelled caller, want %v", err, context.Canceled)
+	}
+
+	close(release)
+	if value := <-second; value != "value" {
+		t.Errorf("got %q for the waiting caller, want %q", value, "value")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/hasura/go-graphql-client"
 )
@@ -21,19 +22,76 @@ type Client struct {
 	RefreshToken   string
 	AccessToken    string
 	OrganizationID string
+	DefaultTeamID  string
+	DefaultTags    map[string]string
+	ReadOnly       bool
+
+	// AllowedOrganizationIDs and AllowedOrganizationSlugs, when set, restrict the organizations the
+	// client authenticates with.
+	AllowedOrganizationIDs   []string
+	AllowedOrganizationSlugs []string
+
+	// CredentialsError is returned when the client authenticates without credentials, it explains
+	// where the credentials were looked for.
+	CredentialsError error
 
 	UserAgent        string
 	BaseURLV3        string
 	BaseURLV4        string
 	AuthBaseURL      string
 	IngestionBaseURL string
+
+	authMu        sync.Mutex
+	authenticated bool
+
+	// catalog caches the catalog endpoints, which are listed to look up a single item.
+	catalog cache
+}
+
+// RegionHosts maps the supported regions to the host of their API.
+var RegionHosts = map[string]string{
+	"us":       "squadcast.com",
+	"eu":       "eu.squadcast.com",
+	"internal": "squadcast.xyz",
+	"staging":  "squadcast.tech",
+	"dev":      "localhost",
+}
+
+// SetRegion sets the host and the base URLs of the client for the region.
+func (client *Client) SetRegion(region string) error {
+	host, ok := RegionHosts[region]
+	if !ok {
+		return fmt.Errorf("unsupported region %q", region)
+	}
+
+	client.Region = region
+	client.Host = host
+
+	if region == "dev" {
+		client.BaseURLV4 = fmt.Sprintf("http://%s:8081/v4", client.Host)
+		client.BaseURLV3 = fmt.Sprintf("http://%s:8081/v3", client.Host)
+		client.AuthBaseURL = fmt.Sprintf("http://%s:8081/v3", client.Host)
+		client.IngestionBaseURL = fmt.Sprintf("http://%s:8458", client.Host)
+	} else {
+		client.BaseURLV4 = fmt.Sprintf("https://api.%s/v4", client.Host)
+		client.BaseURLV3 = fmt.Sprintf("https://api.%s/v3", client.Host)
+		client.AuthBaseURL = fmt.Sprintf("https://api.%s/v3", client.Host)
+		client.IngestionBaseURL = fmt.Sprintf("https://api.%s", client.Host)
+	}
+
+	return nil
+}
+
+// ServiceEmail returns the email address for alerts of a service with the email prefix.
+func (client *Client) ServiceEmail(emailPrefix, organizationSlug string) string {
+	return fmt.Sprintf("%s@%s.incidents.%s", emailPrefix, organizationSlug, client.Host)
 }
 
 type ErrorDetails struct {
-	Code        string `json:"code"`
-	Description string `json:"description,omitempty"`
-	Link        string `json:"link,omitempty"`
-	Errors      any    `json:"errors,omitempty"`
+	Code        string           `json:"code"`
+	Description string           `json:"description,omitempty"`
+	Link        string           `json:"link,omitempty"`
+	Errors      ValidationErrors `json:"errors,omitempty"`
 }
 
 type AppError struct {
@@ -47,12 +105,38 @@ var GraphQLClient *graphql.Client
 
 func (err *AppError) Error() string {
 	str := fmt.Sprintf("[%d] %s", err.Status, err.Message)
-	if err.ErrorDetails != nil {
-		str += fmt.Sprintf("\ndetails: %#v", err.ErrorDetails)
+	if details := err.ErrorDetails; details != nil {
+		str += "\ndetails: " + details.Code
+		if details.Description != "" {
+			str += ": " + details.Description
+		}
+		if len(details.Errors) > 0 {
+			str += "\n" + details.Errors.String()
+		}
 	}
 	return str
 }
 
+// RequestError is returned for requests which the API responded to with an error.
+type RequestError struct {
+	*AppError
+	message string
+}
+
+func (err *RequestError) Error() string {
+	return err.message
+}
+
+// ReadOnlyError is returned for requests which would change Squadcast when the client is read only.
+type ReadOnlyError struct {
+	// Operation describes the refused request, e.g. "POST https://api.squadcast.com/v3/services".
+	Operation string
+}
+
+func (err *ReadOnlyError) Error() string {
+	return fmt.Sprintf("read_only is set on the provider, refusing %s", err.Operation)
+}
+
 // Meta holds the status of the request informations
 type Meta struct {
 	Meta AppError `json:"meta,omitempty"`
@@ -70,7 +154,7 @@ func toHumanReadable(key string) string {
 
 func buildErrorMessage(meta AppError, method, url string) error {
 	if meta.ConflictData == nil {
-		return fmt.Errorf("%s %s returned an error:\n%s", method, url, meta.Error())
+		return &RequestError{AppError: &meta, message: fmt.Sprintf("%s %s returned an error:\n%s", method, url, meta.Error())}
 	}
 
 	var conflictItems []string
@@ -117,10 +201,23 @@ func buildErrorMessage(meta AppError, method, url string) error {
 		}
 	}
 
-	return errors.New(builder.String())
+	return &RequestError{AppError: &meta, message: builder.String()}
 }
 
 func Request[TReq any, TRes any](method string, url string, client *Client, ctx context.Context, payload *TReq) (*TRes, error) {
+	if client.ReadOnly && method != http.MethodGet {
+		return nil, &ReadOnlyError{Operation: method + " " + url}
+	}
+
+	if err := client.Authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	return request[TReq, TRes](method, url, client, ctx, payload)
+}
+
+// request makes a request without authenticating the client first.
+func request[TReq any, TRes any](method string, url string, client *Client, ctx context.Context, payload *TReq) (*TRes, error) {
 	var req *http.Request
 	var err error
 
@@ -176,6 +273,11 @@ func Request[TReq any, TRes any](method string, url string, client *Client, ctx
 
 	if resp.StatusCode > 299 {
 		if response.Meta != nil {
+			if details := response.Meta.Meta.ErrorDetails; details != nil {
+				for _, fieldErr := range details.Errors {
+					fieldErr.Path = AttributePath(reflect.TypeOf((*TReq)(nil)).Elem(), fieldErr.Field)
+				}
+			}
 			return nil, buildErrorMessage(response.Meta.Meta, method, url)
 		} else {
 			return nil, fmt.Errorf("%s %s returned %d with an unexpected error: %#v", method, url, resp.StatusCode, response)
@@ -198,9 +300,54 @@ func IsResourceNotFoundError(e error) bool {
 	return strings.Contains(e.Error(), "[404]")
 }
 
+// IsNameConflictError returns whether a request was refused because an existing object has the same
+// name. The conflict data of the error names the conflicting fields, either as an object of fields or
+// as a list of the conflicting objects. Errors of graphql requests have no conflict data, only a
+// message, which must mention the name.
+func IsNameConflictError(e error, name string) bool {
+	var requestErr *RequestError
+	if !errors.As(e, &requestErr) {
+		message := strings.ToLower(e.Error())
+		return strings.Contains(message, "already exists") && strings.Contains(message, strings.ToLower(name))
+	}
+
+	switch data := requestErr.ConflictData.(type) {
+	case map[string]interface{}:
+		value, ok := data["name"]
+		if !ok || value == nil {
+			return false
+		}
+		v := reflect.ValueOf(value)
+		switch v.Kind() {
+		case reflect.Slice, reflect.Map, reflect.String:
+			return v.Len() > 0
+		case reflect.Bool:
+			return v.Bool()
+		case reflect.Float64:
+			return v.Float() > 0
+		}
+	case []interface{}:
+		for _, item := range data {
+			if itemMap, ok := item.(map[string]interface{}); ok && itemMap["name"] == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // GraphQLRequest is a generic function to make graphql requests
 // method values can be query/mutate
 func GraphQLRequest[TReq any](method string, client *Client, ctx context.Context, payload *TReq, variables map[string]interface{}) (*TReq, error) {
+	if method == "mutate" && client.ReadOnly {
+		return nil, &ReadOnlyError{Operation: "the " + graphQLOperation(payload) + " mutation"}
+	}
+
+	if err := client.Authenticate(ctx); err != nil {
+		return nil, err
+	}
+
 	switch method {
 	case "query":
 		if err := GraphQLClient.WithDebug(false).Query(ctx, payload, variables); err != nil {
@@ -216,3 +363,22 @@ func GraphQLRequest[TReq any](method string, client *Client, ctx context.Context
 
 	return payload, nil
 }
+
+// graphQLOperation returns the name of the operation of a graphql query or mutation struct,
+// e.g. "createSchedule" for a field tagged `graphql:"createSchedule(input: $input)"`.
+func graphQLOperation(payload any) string {
+	t := reflect.TypeOf(payload)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t.NumField() == 0 {
+		return t.Name()
+	}
+
+	name, _, _ := strings.Cut(t.Field(0).Tag.Get("graphql"), "(")
+	if name == "" {
+		return t.Name()
+	}
+
+	return name
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+func TestIsNameConflictError(t *testing.T) {
+	conflict := func(data any) error {
+		return fmt.Errorf("creating: %w", &RequestError{AppError: &AppError{Status: 409, Message: "conflict", ConflictData: data}})
+	}
+
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"name field":          {err: conflict(map[string]any{"name": []any{"payments"}}), want: true},
+		"empty name field":    {err: conflict(map[string]any{"name": []any{}, "email_prefix": []any{"payments"}})},
+		"other field":         {err: conflict(map[string]any{"email_prefix": []any{"payments"}})},
+		"conflicting object":  {err: conflict([]any{map[string]any{"id": "1", "name": "payments"}}), want: true},
+		"other object":        {err: conflict([]any{map[string]any{"id": "1", "name": "billing"}})},
+		"no conflict data":    {err: conflict(nil)},
+		"graphql name":        {err: errors.New(`schedule "Payments" already exists`), want: true},
+		"graphql other":       {err: errors.New(`rotation "billing" already exists`)},
+		"graphql other error": {err: errors.New("payments is invalid")},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsNameConflictError(tc.err, "payments"); got != tc.want {
+				t.Errorf("got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+// newTestClient returns an authenticated client whose REST and graphql requests are served by handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	previous := GraphQLClient
+	GraphQLClient = graphql.NewClient(server.URL+"/graphql", nil)
+	t.Cleanup(func() { GraphQLClient = previous })
+
+	return &Client{
+		AccessToken:   "token",
+		BaseURLV3:     server.URL + "/v3",
+		BaseURLV4:     server.URL + "/v4",
+		authenticated: true,
+	}
+}
+
+// serveGet returns a handler which checks that the request is a GET of path with query, and responds
+// with body.
+func serveGet(t *testing.T, path, query, body string) http.HandlerFunc {
+	t.Helper()
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != path || r.URL.RawQuery != query {
+			t.Errorf("got %s %s?%s, want GET %s?%s", r.Method, r.URL.Path, r.URL.RawQuery, path, query)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"id": "s1", "name": "payments"}}`))
+	})
+	client.ReadOnly = true
+	url := client.BaseURLV3 + "/services"
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		t.Run(method, func(t *testing.T) {
+			_, err := Request[any, Service](method, url, client, context.Background(), nil)
+			var readOnlyErr *ReadOnlyError
+			if !errors.As(err, &readOnlyErr) {
+				t.Fatalf("got error %v, want a ReadOnlyError", err)
+			}
+			if want := method + " " + url; readOnlyErr.Operation != want {
+				t.Errorf("got operation %q, want %q", readOnlyErr.Operation, want)
+			}
+		})
+	}
+
+	t.Run("mutate", func(t *testing.T) {
+		_, err := GraphQLRequest("mutate", client, context.Background(), &CreateScheduleRotationMutateStruct{}, map[string]any{})
+		var readOnlyErr *ReadOnlyError
+		if !errors.As(err, &readOnlyErr) {
+			t.Fatalf("got error %v, want a ReadOnlyError", err)
+		}
+		if want := "the createRotation mutation"; readOnlyErr.Operation != want {
+			t.Errorf("got operation %q, want %q", readOnlyErr.Operation, want)
+		}
+	})
+
+	if len(requests) != 0 {
+		t.Fatalf("got requests %v, want none to reach the server", requests)
+	}
+
+	t.Run(http.MethodGet, func(t *testing.T) {
+		service, err := Request[any, Service](http.MethodGet, url+"/s1", client, context.Background(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if service.ID != "s1" {
+			t.Errorf("got service %q, want s1", service.ID)
+		}
+		if want := []string{"GET /v3/services/s1"}; !slices.Equal(requests, want) {
+			t.Errorf("got requests %v, want %v", requests, want)
+		}
+	})
+}
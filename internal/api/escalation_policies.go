@@ -9,9 +9,9 @@ import (
 )
 
 type EscalationPolicyTarget struct {
-	ID   string `json:"id,omitempty"`
-	Type string `json:"type"`
-	PID  int    `json:"pid,omitempty"`
+	ID   string `json:"id,omitempty" tfpath:"id"`
+	Type string `json:"type" tfpath:"type"`
+	PID  int    `json:"pid,omitempty" tfpath:"id"`
 }
 
 func (t *EscalationPolicyTarget) Encode() (tf.M, error) {
@@ -28,13 +28,13 @@ func (t *EscalationPolicyTarget) Encode() (tf.M, error) {
 }
 
 type EscalationPolicyRule struct {
-	EscalateAfterMinutes     int                       `json:"escalationTime"`
-	Via                      []string                  `json:"via"`
-	Targets                  []*EscalationPolicyTarget `json:"entities"`
-	RoundrobinEnabled        bool                      `json:"roundrobin_enabled"`
-	EscalateWithinRoundrobin bool                      `json:"escalate_within_roundrobin"`
-	RepeatTimes              int                       `json:"repetition"`
-	RepeatAfterMinutes       int                       `json:"repeat_after"`
+	EscalateAfterMinutes     int                       `json:"escalationTime" tfpath:"delay_minutes"`
+	Via                      []string                  `json:"via" tfpath:"notification_channels"`
+	Targets                  []*EscalationPolicyTarget `json:"entities" tfpath:"targets"`
+	RoundrobinEnabled        bool                      `json:"roundrobin_enabled" tfpath:"round_robin.0.enabled"`
+	EscalateWithinRoundrobin bool                      `json:"escalate_within_roundrobin" tfpath:"round_robin.0.rotation.0.enabled"`
+	RepeatTimes              int                       `json:"repetition" tfpath:"repeat.0.times"`
+	RepeatAfterMinutes       int                       `json:"repeat_after" tfpath:"repeat.0.delay_minutes"`
 }
 
 func (r *EscalationPolicyRule) Encode() (tf.M, error) {
@@ -155,15 +155,17 @@ func (client *Client) ListEscalationPolicies(ctx context.Context, teamID string)
 	return RequestSlice[any, EscalationPolicy](http.MethodGet, url, client, ctx, nil)
 }
 
+// CreateUpdateEscalationPolicyReq is tagged with the attributes of its fields, so that the errors of
+// the API name them.
 type CreateUpdateEscalationPolicyReq struct {
-	TeamID             string                 `json:"owner_id"`
-	Name               string                 `json:"name"`
-	Description        string                 `json:"description"`
-	RepeatTimes        int                    `json:"repetition"`
-	RepeatAfterMinutes int                    `json:"repeat_after"`
-	Rules              []EscalationPolicyRule `json:"rules"`
+	TeamID             string                 `json:"owner_id" tfpath:"team_id"`
+	Name               string                 `json:"name" tfpath:"name"`
+	Description        string                 `json:"description" tfpath:"description"`
+	RepeatTimes        int                    `json:"repetition" tfpath:"repeat.0.times"`
+	RepeatAfterMinutes int                    `json:"repeat_after" tfpath:"repeat.0.delay_minutes"`
+	Rules              []EscalationPolicyRule `json:"rules" tfpath:"rules"`
 	IsUsingNewFields   bool                   `json:"is_using_new_fields"`
-	EntityOwner        *EntityOwner           `json:"entity_owner"`
+	EntityOwner        *EntityOwner           `json:"entity_owner" tfpath:"entity_owner"`
 }
 
 func (client *Client) CreateEscalationPolicy(ctx context.Context, req *CreateUpdateEscalationPolicyReq) (*EscalationPolicy, error) {
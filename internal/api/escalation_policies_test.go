@@ -0,0 +1,19 @@
+package api
+
+import (
+	"context"
+	"testing"
+)
+
+func TestListEscalationPolicies(t *testing.T) {
+	client := newTestClient(t, serveGet(t, "/v3/escalation-policies", "owner_id=team1",
+		`{"data": [{"id": "e1", "name": "on call", "repetition": 2, "owner": {"id": "team1", "type": "team"}}]}`))
+
+	policies, err := client.ListEscalationPolicies(context.Background(), "team1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(policies) != 1 || policies[0].ID != "e1" || policies[0].Name != "on call" || policies[0].RepeatTimes != 2 || policies[0].Owner.ID != "team1" {
+		t.Fatalf("got %+v", policies)
+	}
+}
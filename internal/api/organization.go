@@ -4,16 +4,43 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
 type Organization struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-	Slug string `json:"slug"`
+	ID   string `json:"id" tf:"id"`
+	Name string `json:"name" tf:"name"`
+	Slug string `json:"slug" tf:"slug"`
+}
+
+func (o *Organization) Encode() (tf.M, error) {
+	return tf.Encode(o)
 }
 
 func (client *Client) GetCurrentOrganization(ctx context.Context) (*Organization, error) {
+	if err := client.Authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	return client.getCurrentOrganization(ctx)
+}
+
+func (client *Client) getCurrentOrganization(ctx context.Context) (*Organization, error) {
 	url := fmt.Sprintf("%s/organization", client.BaseURLV3)
 
-	return Request[any, Organization](http.MethodGet, url, client, ctx, nil)
+	return request[any, Organization](http.MethodGet, url, client, ctx, nil)
+}
+
+// checkOrganization returns an error if the organization is not allowed for the client.
+func (client *Client) checkOrganization(org *Organization) error {
+	if len(client.AllowedOrganizationIDs) > 0 && !slices.Contains(client.AllowedOrganizationIDs, org.ID) {
+		return fmt.Errorf("the credentials belong to the organization %s (%s), which is not in allowed_organization_ids", org.Slug, org.ID)
+	}
+	if len(client.AllowedOrganizationSlugs) > 0 && !slices.Contains(client.AllowedOrganizationSlugs, org.Slug) {
+		return fmt.Errorf("the credentials belong to the organization %s (%s), which is not in allowed_organization_slugs", org.Slug, org.ID)
+	}
+
+	return nil
 }
@@ -2,7 +2,9 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
@@ -106,6 +108,149 @@ func (rot NewRotation) Encode() (tf.M, error) {
 	return m, nil
 }
 
+// RotationShift is a single on-call shift of a rotation.
+type RotationShift struct {
+	Start time.Time
+	End   time.Time
+	// ParticipantGroup is the index of the participant group on call, or -1 if the rotation has no participants.
+	ParticipantGroup int
+}
+
+// maxRotationDays bounds the number of days a rotation is expanded for.
+const maxRotationDays = 100000
+
+var rotationWeekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// iterationStart returns the first day of the i-th iteration of the rotation, starting at start.
+func (rot *NewRotation) iterationStart(start time.Time, i int) (time.Time, error) {
+	switch rot.Period {
+	case "none", "daily":
+		return start.AddDate(0, 0, i), nil
+	case "weekly":
+		return start.AddDate(0, 0, 7*i), nil
+	case "monthly":
+		return start.AddDate(0, i, 0), nil
+	case "custom":
+		if rot.CustomPeriodFrequency < 1 {
+			return time.Time{}, fmt.Errorf("custom_period_frequency must be set when period is custom")
+		}
+		switch rot.CustomPeriodUnit {
+		case "day":
+			return start.AddDate(0, 0, rot.CustomPeriodFrequency*i), nil
+		case "week":
+			return start.AddDate(0, 0, 7*rot.CustomPeriodFrequency*i), nil
+		}
+		return time.Time{}, fmt.Errorf("unsupported custom_period_unit %q", rot.CustomPeriodUnit)
+	}
+	return time.Time{}, fmt.Errorf("unsupported period %q", rot.Period)
+}
+
+// Shifts expands the rotation into the shifts which overlap the range [from, till). Shift times are
+// interpreted in the time zone of the schedule. Every iteration of the rotation activates the shift
+// timeslots on each of its days, and the participant groups take turns as set by the change
+// participants frequency and unit.
+func (rot *NewRotation) Shifts(loc *time.Location, from, till time.Time) ([]RotationShift, error) {
+	startDate, err := time.Parse(time.RFC3339, rot.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start_date %q: %w", rot.StartDate, err)
+	}
+	start := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, loc)
+
+	var endDate time.Time
+	if rot.EndDate != "" {
+		endDate, err = time.Parse(time.RFC3339, rot.EndDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end_date %q: %w", rot.EndDate, err)
+		}
+		endDate = time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1)
+	}
+
+	for _, slot := range rot.ShiftTimeSlots {
+		if _, ok := rotationWeekdays[slot.DayOfWeek]; slot.DayOfWeek != "" && !ok {
+			return nil, fmt.Errorf("invalid day_of_week %q", slot.DayOfWeek)
+		}
+	}
+
+	changeFrequency := rot.ChangeParticipantsFrequency
+	if changeFrequency < 1 {
+		changeFrequency = 1
+	}
+
+	shifts := []RotationShift{}
+	days := 0
+	for i := 0; rot.EndsAfterIterations == 0 || i < rot.EndsAfterIterations; i++ {
+		iterationStart, err := rot.iterationStart(start, i)
+		if err != nil {
+			return nil, err
+		}
+		iterationEnd, err := rot.iterationStart(start, i+1)
+		if err != nil {
+			return nil, err
+		}
+		if rot.Period == "none" && i > 0 {
+			return shifts, nil
+		}
+
+		for day := iterationStart; day.Before(iterationEnd); day = day.AddDate(0, 0, 1) {
+			if !day.Before(till) || (!endDate.IsZero() && !day.Before(endDate)) {
+				return shifts, nil
+			}
+			if days++; days > maxRotationDays {
+				return nil, fmt.Errorf("the range is too large, it covers more than %d days of the rotation", maxRotationDays)
+			}
+
+			group := -1
+			if n := len(rot.ParticipantGroups); n > 0 {
+				var turn int
+				switch rot.ChangeParticipantsUnit {
+				case "day":
+					turn = calendarDays(start, day) / changeFrequency
+				case "week":
+					turn = calendarDays(start, day) / 7 / changeFrequency
+				case "month":
+					turn = ((day.Year()-start.Year())*12 + int(day.Month()-start.Month())) / changeFrequency
+				default:
+					turn = i / changeFrequency
+				}
+				group = turn % n
+			}
+
+			for _, slot := range rot.ShiftTimeSlots {
+				if slot.DayOfWeek != "" && rotationWeekdays[slot.DayOfWeek] != day.Weekday() {
+					continue
+				}
+
+				shiftStart := time.Date(day.Year(), day.Month(), day.Day(), slot.StartHour, slot.StartMinute, 0, 0, loc)
+				shiftEnd := shiftStart.Add(time.Duration(slot.Duration) * time.Minute)
+				if shiftEnd.After(from) && shiftStart.Before(till) {
+					shifts = append(shifts, RotationShift{
+						Start:            shiftStart,
+						End:              shiftEnd,
+						ParticipantGroup: group,
+					})
+				}
+			}
+		}
+	}
+
+	return shifts, nil
+}
+
+// calendarDays returns the number of calendar days from a to b, independent of daylight saving time.
+func calendarDays(a, b time.Time) int {
+	ua := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	ub := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(ub.Sub(ua).Hours() / 24)
+}
+
 // ScheduleV2 APIs
 func (client *Client) DeleteScheduleRotationByID(ctx context.Context, ID string) (*DeleteScheduleRotationMutateStruct, error) {
 	var m DeleteScheduleRotationMutateStruct
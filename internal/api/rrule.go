@@ -0,0 +1,240 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RRule is the subset of an RFC 5545 recurrence rule that can be expressed as a suppression rule TimeSlot.
+type RRule struct {
+	Freq     string
+	Interval int
+	ByDay    []int
+	Count    int
+	Until    time.Time
+}
+
+var rruleWeekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+const rruleUntilLayout = "20060102T150405Z"
+
+// timeSlotTimeLayout is the timestamp layout used by the API for time slots.
+const timeSlotTimeLayout = "2006-01-02T15:04:05.000Z"
+
+// ParseRRule parses a recurrence rule such as `FREQ=WEEKLY;BYDAY=SA,SU` and returns an error for
+// rules that cannot be represented by a suppression rule time slot.
+func ParseRRule(rrule string) (*RRule, error) {
+	rrule = strings.TrimPrefix(strings.TrimSpace(rrule), "RRULE:")
+	if rrule == "" {
+		return nil, fmt.Errorf("rrule cannot be empty")
+	}
+
+	r := &RRule{Interval: 1}
+	for _, part := range strings.Split(rrule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid rrule part %q, expected KEY=VALUE", part)
+		}
+
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			r.Freq = strings.ToUpper(value)
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval < 1 {
+				return nil, fmt.Errorf("invalid INTERVAL %q, expected a positive number", value)
+			}
+			r.Interval = interval
+		case "BYDAY":
+			for _, day := range strings.Split(strings.ToUpper(value), ",") {
+				idx := indexOf(rruleWeekdays, day)
+				if idx < 0 {
+					return nil, fmt.Errorf("unsupported BYDAY value %q, expected one of %s without an ordinal", day, strings.Join(rruleWeekdays, ", "))
+				}
+				r.ByDay = append(r.ByDay, idx)
+			}
+			sort.Ints(r.ByDay)
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count != 1 {
+				return nil, fmt.Errorf("unsupported COUNT %q, only COUNT=1 (a single occurrence) is supported, use UNTIL instead", value)
+			}
+			r.Count = count
+		case "UNTIL":
+			until, err := time.Parse(rruleUntilLayout, strings.ToUpper(value))
+			if err != nil {
+				return nil, fmt.Errorf("invalid UNTIL %q, expected a UTC timestamp like 20240131T000000Z", value)
+			}
+			r.Until = until
+		case "WKST":
+			// The week start has no effect on the supported rules.
+		default:
+			return nil, fmt.Errorf("unsupported rrule part %s", strings.ToUpper(key))
+		}
+	}
+
+	switch r.Freq {
+	case "DAILY", "WEEKLY", "MONTHLY":
+	case "":
+		return nil, fmt.Errorf("rrule must contain FREQ")
+	default:
+		return nil, fmt.Errorf("unsupported FREQ %s, expected DAILY, WEEKLY or MONTHLY", r.Freq)
+	}
+
+	if len(r.ByDay) > 0 && r.Freq != "WEEKLY" {
+		return nil, fmt.Errorf("BYDAY is only supported with FREQ=WEEKLY")
+	}
+	if r.Count == 1 && (r.Interval != 1 || len(r.ByDay) > 0 || !r.Until.IsZero()) {
+		return nil, fmt.Errorf("COUNT=1 cannot be combined with INTERVAL, BYDAY or UNTIL")
+	}
+	// A single occurrence does not repeat, so its FREQ is irrelevant and is read back as DAILY.
+	if r.Count == 1 {
+		r.Freq = "DAILY"
+	}
+
+	return r, nil
+}
+
+// String returns the canonical form of the rule.
+func (r *RRule) String() string {
+	parts := []string{"FREQ=" + r.Freq}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, d := range r.ByDay {
+			days[i] = rruleWeekdays[d]
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(rruleUntilLayout))
+	}
+	return strings.Join(parts, ";")
+}
+
+// TimeSlotFromRRule translates a recurrence rule starting at dtstart and lasting for duration into a TimeSlot.
+func TimeSlotFromRRule(timeZone, rrule, dtstart, duration string, isAllDay bool) (*TimeSlot, error) {
+	r, err := ParseRRule(rrule)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := time.Parse(time.RFC3339, dtstart)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dtstart %q, expected an RFC 3339 timestamp", dtstart)
+	}
+
+	length, err := time.ParseDuration(duration)
+	if err != nil || length <= 0 {
+		return nil, fmt.Errorf("invalid duration %q, expected a positive duration like 2h or 30m", duration)
+	}
+
+	end := start.Add(length)
+	slot := &TimeSlot{
+		TimeZone:  timeZone,
+		StartTime: start.UTC().Format(timeSlotTimeLayout),
+		EndTime:   end.UTC().Format(timeSlotTimeLayout),
+		IsAllDay:  isAllDay,
+		EndsNever: r.Until.IsZero(),
+		EndsOn:    end.UTC().Format(timeSlotTimeLayout),
+	}
+	if !r.Until.IsZero() {
+		if r.Until.Before(start) {
+			return nil, fmt.Errorf("UNTIL must not be before dtstart")
+		}
+		slot.EndsOn = r.Until.UTC().Format(timeSlotTimeLayout)
+	}
+
+	repeats := map[string]string{"DAILY": "day", "WEEKLY": "week", "MONTHLY": "month"}[r.Freq]
+	switch {
+	case r.Count == 1:
+		slot.Repetition = "none"
+		slot.EndsNever = false
+	case r.Interval == 1 && len(r.ByDay) == 0:
+		slot.Repetition = map[string]string{"DAILY": "daily", "WEEKLY": "weekly", "MONTHLY": "monthly"}[r.Freq]
+	default:
+		slot.Repetition = "custom"
+		slot.IsCustom = true
+		slot.Custom = &CustomTime{
+			Repeats:      repeats,
+			RepeatsCount: r.Interval,
+		}
+		switch repeats {
+		case "week":
+			slot.Custom.RepeatsOnWeekdays = r.ByDay
+		case "month":
+			slot.Custom.RepeatsOnMonth = "date-occurrence"
+		}
+	}
+
+	return slot, nil
+}
+
+// RRule translates the time slot back into a recurrence rule, its start and its duration.
+func (t *TimeSlot) RRule() (rrule string, dtstart string, duration string, err error) {
+	start, err := time.Parse(time.RFC3339, t.StartTime)
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid start_time %q", t.StartTime)
+	}
+	end, err := time.Parse(time.RFC3339, t.EndTime)
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid end_time %q", t.EndTime)
+	}
+
+	r := &RRule{Interval: 1}
+	switch t.Repetition {
+	case "none":
+		r.Freq = "DAILY"
+		r.Count = 1
+	case "daily":
+		r.Freq = "DAILY"
+	case "weekly":
+		r.Freq = "WEEKLY"
+	case "monthly":
+		r.Freq = "MONTHLY"
+	case "custom":
+		if t.Custom == nil {
+			return "", "", "", fmt.Errorf("custom repetition without custom settings")
+		}
+		r.Freq = map[string]string{"day": "DAILY", "week": "WEEKLY", "month": "MONTHLY"}[t.Custom.Repeats]
+		if r.Freq == "" {
+			return "", "", "", fmt.Errorf("unsupported custom repetition %q", t.Custom.Repeats)
+		}
+		if t.Custom.RepeatsCount > 1 {
+			r.Interval = t.Custom.RepeatsCount
+		}
+		if r.Freq == "WEEKLY" {
+			r.ByDay = append([]int{}, t.Custom.RepeatsOnWeekdays...)
+			sort.Ints(r.ByDay)
+		}
+	default:
+		return "", "", "", fmt.Errorf("unsupported repetition %q", t.Repetition)
+	}
+
+	if r.Count == 0 && !t.EndsNever {
+		until, err := time.Parse(time.RFC3339, t.EndsOn)
+		if err != nil {
+			return "", "", "", fmt.Errorf("invalid ends_on %q", t.EndsOn)
+		}
+		r.Until = until
+	}
+
+	return r.String(), start.UTC().Format(time.RFC3339), end.Sub(start).String(), nil
+}
+
+func indexOf(list []string, value string) int {
+	for i, v := range list {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,175 @@
+package api
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseRRule(t *testing.T) {
+	cases := map[string]struct {
+		rrule   string
+		want    string
+		wantErr string
+	}{
+		"weekly by day":         {rrule: "RRULE:freq=weekly;byday=su,sa", want: "FREQ=WEEKLY;BYDAY=SU,SA"},
+		"interval":              {rrule: "FREQ=DAILY;INTERVAL=3", want: "FREQ=DAILY;INTERVAL=3"},
+		"interval of one":       {rrule: "FREQ=MONTHLY;INTERVAL=1", want: "FREQ=MONTHLY"},
+		"until":                 {rrule: "FREQ=DAILY;UNTIL=20240131T000000Z", want: "FREQ=DAILY;UNTIL=20240131T000000Z"},
+		"week start":            {rrule: "FREQ=WEEKLY;WKST=MO", want: "FREQ=WEEKLY"},
+		"single occurrence":     {rrule: "FREQ=DAILY;COUNT=1", want: "FREQ=DAILY;COUNT=1"},
+		"single weekly":         {rrule: "FREQ=WEEKLY;COUNT=1", want: "FREQ=DAILY;COUNT=1"},
+		"single monthly":        {rrule: "FREQ=MONTHLY;COUNT=1", want: "FREQ=DAILY;COUNT=1"},
+		"empty":                 {rrule: " ", wantErr: "cannot be empty"},
+		"missing value":         {rrule: "FREQ=", wantErr: "expected KEY=VALUE"},
+		"missing freq":          {rrule: "INTERVAL=2", wantErr: "must contain FREQ"},
+		"yearly":                {rrule: "FREQ=YEARLY", wantErr: "unsupported FREQ YEARLY"},
+		"zero interval":         {rrule: "FREQ=DAILY;INTERVAL=0", wantErr: "invalid INTERVAL"},
+		"ordinal day":           {rrule: "FREQ=WEEKLY;BYDAY=1MO", wantErr: "unsupported BYDAY value"},
+		"by day not weekly":     {rrule: "FREQ=DAILY;BYDAY=MO", wantErr: "only supported with FREQ=WEEKLY"},
+		"count":                 {rrule: "FREQ=DAILY;COUNT=5", wantErr: "unsupported COUNT"},
+		"count with interval":   {rrule: "FREQ=DAILY;COUNT=1;INTERVAL=2", wantErr: "cannot be combined"},
+		"invalid until":         {rrule: "FREQ=DAILY;UNTIL=2024-01-31", wantErr: "invalid UNTIL"},
+		"unsupported part":      {rrule: "FREQ=DAILY;BYHOUR=9", wantErr: "unsupported rrule part BYHOUR"},
+		"lower case until zone": {rrule: "FREQ=DAILY;UNTIL=20240131t000000z", want: "FREQ=DAILY;UNTIL=20240131T000000Z"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := ParseRRule(tc.rrule)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := r.String(); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTimeSlotFromRRule(t *testing.T) {
+	cases := map[string]struct {
+		rrule   string
+		want    TimeSlot
+		wantErr string
+	}{
+		"weekly": {
+			rrule: "FREQ=WEEKLY",
+			want:  TimeSlot{Repetition: "weekly", EndsNever: true},
+		},
+		"single occurrence": {
+			rrule: "FREQ=MONTHLY;COUNT=1",
+			want:  TimeSlot{Repetition: "none"},
+		},
+		"custom weekly": {
+			rrule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=SA,SU;UNTIL=20240301T000000Z",
+			want: TimeSlot{
+				Repetition: "custom",
+				IsCustom:   true,
+				Custom:     &CustomTime{Repeats: "week", RepeatsCount: 2, RepeatsOnWeekdays: []int{0, 6}},
+				EndsOn:     "2024-03-01T00:00:00.000Z",
+			},
+		},
+		"custom monthly": {
+			rrule: "FREQ=MONTHLY;INTERVAL=3",
+			want: TimeSlot{
+				Repetition: "custom",
+				IsCustom:   true,
+				Custom:     &CustomTime{Repeats: "month", RepeatsCount: 3, RepeatsOnMonth: "date-occurrence"},
+				EndsNever:  true,
+			},
+		},
+		"until before start": {
+			rrule:   "FREQ=DAILY;UNTIL=20231231T000000Z",
+			wantErr: "UNTIL must not be before dtstart",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			slot, err := TimeSlotFromRRule("Asia/Kolkata", tc.rrule, "2024-01-06T22:00:00+05:30", "2h30m", false)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if slot.TimeZone != "Asia/Kolkata" || slot.StartTime != "2024-01-06T16:30:00.000Z" || slot.EndTime != "2024-01-06T19:00:00.000Z" {
+				t.Errorf("got time zone %q, start %q and end %q", slot.TimeZone, slot.StartTime, slot.EndTime)
+			}
+			if slot.Repetition != tc.want.Repetition || slot.IsCustom != tc.want.IsCustom || slot.EndsNever != tc.want.EndsNever {
+				t.Errorf("got repetition %q, custom %t and ends never %t", slot.Repetition, slot.IsCustom, slot.EndsNever)
+			}
+			if tc.want.EndsOn != "" && slot.EndsOn != tc.want.EndsOn {
+				t.Errorf("got ends on %q, want %q", slot.EndsOn, tc.want.EndsOn)
+			}
+			if (slot.Custom == nil) != (tc.want.Custom == nil) {
+				t.Fatalf("got custom %#v, want %#v", slot.Custom, tc.want.Custom)
+			}
+			if slot.Custom != nil && (slot.Custom.Repeats != tc.want.Custom.Repeats ||
+				slot.Custom.RepeatsCount != tc.want.Custom.RepeatsCount ||
+				slot.Custom.RepeatsOnMonth != tc.want.Custom.RepeatsOnMonth ||
+				!slices.Equal(slot.Custom.RepeatsOnWeekdays, tc.want.Custom.RepeatsOnWeekdays)) {
+				t.Errorf("got custom %#v, want %#v", slot.Custom, tc.want.Custom)
+			}
+		})
+	}
+
+	for _, invalid := range []struct{ dtstart, duration, wantErr string }{
+		{"2024-01-06 22:00", "1h", "invalid dtstart"},
+		{"2024-01-06T22:00:00Z", "0s", "invalid duration"},
+		{"2024-01-06T22:00:00Z", "1 hour", "invalid duration"},
+	} {
+		if _, err := TimeSlotFromRRule("UTC", "FREQ=DAILY", invalid.dtstart, invalid.duration, false); err == nil || !strings.Contains(err.Error(), invalid.wantErr) {
+			t.Errorf("dtstart %q and duration %q: got error %v, want %q", invalid.dtstart, invalid.duration, err, invalid.wantErr)
+		}
+	}
+}
+
+func TestTimeSlotRRuleRoundTrip(t *testing.T) {
+	for _, rrule := range []string{
+		"FREQ=DAILY",
+		"FREQ=WEEKLY",
+		"FREQ=MONTHLY",
+		"FREQ=DAILY;COUNT=1",
+		"FREQ=WEEKLY;COUNT=1",
+		"FREQ=DAILY;INTERVAL=2",
+		"FREQ=WEEKLY;BYDAY=MO,WE,FR",
+		"FREQ=WEEKLY;INTERVAL=2;BYDAY=SU,SA;UNTIL=20240301T000000Z",
+		"FREQ=MONTHLY;INTERVAL=6",
+		"FREQ=DAILY;UNTIL=20241231T235959Z",
+	} {
+		t.Run(rrule, func(t *testing.T) {
+			slot, err := TimeSlotFromRRule("UTC", rrule, "2024-01-06T22:00:00Z", "90m", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotRRule, dtstart, duration, err := slot.RRule()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want, err := ParseRRule(rrule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gotRRule != want.String() {
+				t.Errorf("got rrule %q, want %q", gotRRule, want.String())
+			}
+			if dtstart != "2024-01-06T22:00:00Z" || duration != "1h30m0s" {
+				t.Errorf("got dtstart %q and duration %q", dtstart, duration)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package api
+
+import (
+	"context"
+	"testing"
+)
+
+func TestListRunbooks(t *testing.T) {
+	client := newTestClient(t, serveGet(t, "/v3/runbooks", "owner_id=team1",
+		`{"data": [{"id": "r1", "name": "restart", "steps": [{"content": "restart the pods"}]}]}`))
+
+	runbooks, err := client.ListRunbooks(context.Background(), "team1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runbooks) != 1 || runbooks[0].ID != "r1" || runbooks[0].Name != "restart" {
+		t.Fatalf("got %+v", runbooks)
+	}
+	if len(runbooks[0].Steps) != 1 || runbooks[0].Steps[0].Content != "restart the pods" {
+		t.Errorf("got steps %+v", runbooks[0].Steps)
+	}
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetScheduleV2ByName(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		if r.Method != http.MethodPost || r.URL.Path != "/graphql" {
+			t.Errorf("got %s %s, want POST /graphql", r.Method, r.URL.Path)
+		}
+		if !strings.Contains(body.Query, "schedules(filters:  { scheduleName: $scheduleName, teamID: $teamID })") {
+			t.Errorf("got query %s", body.Query)
+		}
+		if body.Variables["scheduleName"] != "primary" || body.Variables["teamID"] != "team1" {
+			t.Errorf("got variables %v", body.Variables)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"schedules": [{"ID": 7, "name": "primary", "timeZone": "Asia/Kolkata", "teamID": "team1", "owner": {"ID": "team1", "type": "team"}, "tags": [{"key": "env", "value": "prod", "color": "#fff"}]}]}}`))
+	})
+
+	schedules, err := client.GetScheduleV2ByName(context.Background(), "team1", "primary")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schedules.NewSchedule) != 1 {
+		t.Fatalf("got %d schedules, want 1", len(schedules.NewSchedule))
+	}
+	schedule := schedules.NewSchedule[0]
+	if schedule.ID != 7 || schedule.Name != "primary" || schedule.TimeZone != "Asia/Kolkata" || schedule.TeamID != "team1" {
+		t.Errorf("got %+v", schedule)
+	}
+	if len(schedule.Tags) != 1 || schedule.Tags[0].Key != "env" {
+		t.Errorf("got tags %+v", schedule.Tags)
+	}
+}
@@ -2,8 +2,10 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
@@ -20,19 +22,80 @@ type ServiceMaintenanceWindow struct {
 	RepeatMonthly     bool   `json:"repetition_monthly" tf:"-"`
 }
 
-func (s *ServiceMaintenanceWindow) Encode() (tf.M, error) {
+// Frequency returns the repeat frequency of the window, derived from the repetition flags
+// when the window was returned by the API.
+func (s *ServiceMaintenanceWindow) Frequency() string {
+	if s.RepeatFrequency != "" {
+		return s.RepeatFrequency
+	}
+
 	if s.RepeatDaily {
-		s.RepeatFrequency = "day"
+		return "day"
 	} else if s.RepeatWeekly {
-		s.RepeatFrequency = "week"
+		return "week"
 	} else if s.RepeatTwoWeekly {
-		s.RepeatFrequency = "2 weeks"
+		return "2 weeks"
 	} else if s.RepeatThreeWeekly {
-		s.RepeatFrequency = "3 weeks"
+		return "3 weeks"
 	} else if s.RepeatMonthly {
-		s.RepeatFrequency = "month"
+		return "month"
+	}
+
+	return ""
+}
+
+// UpdateWindow converts the window into the shape expected by UpdateServiceMaintenance.
+func (s *ServiceMaintenanceWindow) UpdateWindow() UpdateServiceMaintenanceWindowsWindow {
+	uw := UpdateServiceMaintenanceWindowsWindow{
+		From:       s.From,
+		Till:       s.Till,
+		RepeatTill: s.RepeatTill,
+	}
+
+	switch s.Frequency() {
+	case "":
+		uw.RepeatTill = uw.Till
+	case "day":
+		uw.Daily = true
+	case "week":
+		uw.Weekly = true
+	case "2 weeks":
+		uw.TwoWeekly = true
+	case "3 weeks":
+		uw.ThreeWeekly = true
+	case "month":
+		uw.Monthly = true
+	}
+
+	return uw
+}
+
+// Equal reports whether both windows describe the same maintenance, ignoring timestamp formatting.
+func (s *ServiceMaintenanceWindow) Equal(other *ServiceMaintenanceWindow) bool {
+	frequency := s.Frequency()
+	if frequency != other.Frequency() {
+		return false
+	}
+
+	if !sameInstant(s.From, other.From) || !sameInstant(s.Till, other.Till) {
+		return false
 	}
 
+	return frequency == "" || sameInstant(s.RepeatTill, other.RepeatTill)
+}
+
+func sameInstant(a, b string) bool {
+	ta, errA := time.Parse(time.RFC3339, a)
+	tb, errB := time.Parse(time.RFC3339, b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return ta.Equal(tb)
+}
+
+func (s *ServiceMaintenanceWindow) Encode() (tf.M, error) {
+	s.RepeatFrequency = s.Frequency()
+
 	if s.RepeatFrequency == "" {
 		s.RepeatTill = ""
 	}
@@ -76,3 +139,147 @@ func (client *Client) UpdateServiceMaintenance(ctx context.Context, serviceID st
 	url := fmt.Sprintf("%s/services/%s/maintenance", client.BaseURLV3, serviceID)
 	return Request[UpdateServiceMaintenanceWindows, any](http.MethodPost, url, client, ctx, req)
 }
+
+// ErrMaintenanceWindowExists is returned when adding a window to a service which already has an
+// identical one. Windows have no owner, so the existing window cannot be told apart from the added one.
+var ErrMaintenanceWindowExists = errors.New("an identical maintenance window already exists on the service, remove it or change the window so that it can be told apart")
+
+// AddServiceMaintenanceWindow adds the window to the maintenance windows of the service,
+// keeping all other windows. It returns ErrMaintenanceWindowExists if the service already has
+// an identical window.
+func (client *Client) AddServiceMaintenanceWindow(ctx context.Context, serviceID string, window *ServiceMaintenanceWindow) error {
+	windows, err := client.GetServiceMaintenanceWindows(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+
+	updateWindows := make([]UpdateServiceMaintenanceWindowsWindow, 0, len(windows)+1)
+	for _, w := range windows {
+		if w.Equal(window) {
+			return ErrMaintenanceWindowExists
+		}
+		updateWindows = append(updateWindows, w.UpdateWindow())
+	}
+	updateWindows = append(updateWindows, window.UpdateWindow())
+
+	return client.updateServiceMaintenanceWindows(ctx, serviceID, updateWindows)
+}
+
+// RemoveServiceMaintenanceWindow removes the window from the maintenance windows of the service,
+// keeping all other windows. Removing a window which does not exist is a no-op.
+func (client *Client) RemoveServiceMaintenanceWindow(ctx context.Context, serviceID string, window *ServiceMaintenanceWindow) error {
+	windows, err := client.GetServiceMaintenanceWindows(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	updateWindows := make([]UpdateServiceMaintenanceWindowsWindow, 0, len(windows))
+	for _, w := range windows {
+		if !found && w.Equal(window) {
+			found = true
+			continue
+		}
+		updateWindows = append(updateWindows, w.UpdateWindow())
+	}
+	if !found {
+		return nil
+	}
+
+	return client.updateServiceMaintenanceWindows(ctx, serviceID, updateWindows)
+}
+
+func (client *Client) updateServiceMaintenanceWindows(ctx context.Context, serviceID string, windows []UpdateServiceMaintenanceWindowsWindow) error {
+	orgID, err := client.OrganizationIDFor(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.UpdateServiceMaintenance(ctx, serviceID, &UpdateServiceMaintenanceWindows{
+		OrganizationID: orgID,
+		ServiceID:      serviceID,
+		Data: UpdateServiceMaintenanceWindowsData{
+			ServiceMaintenanceWindows: windows,
+		},
+	})
+	return err
+}
+
+type MaintenanceOccurrence struct {
+	Start time.Time
+	End   time.Time
+}
+
+// maxMaintenanceOccurrences bounds the number of occurrences a single recurring window is expanded into.
+const maxMaintenanceOccurrences = 10000
+
+// Occurrences expands the window into the concrete occurrences which overlap the range [from, till).
+func (s *ServiceMaintenanceWindow) Occurrences(from, till time.Time) ([]MaintenanceOccurrence, error) {
+	start, err := time.Parse(time.RFC3339, s.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance start %q: %w", s.From, err)
+	}
+	end, err := time.Parse(time.RFC3339, s.Till)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance end %q: %w", s.Till, err)
+	}
+	length := end.Sub(start)
+
+	frequency := s.Frequency()
+	repeatTill := start
+	if frequency != "" {
+		repeatTill, err = time.Parse(time.RFC3339, s.RepeatTill)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maintenance repeat till %q: %w", s.RepeatTill, err)
+		}
+	}
+
+	occurrences := []MaintenanceOccurrence{}
+	for i := 0; ; i++ {
+		var occurrenceStart time.Time
+		switch frequency {
+		case "":
+			if i > 0 {
+				return occurrences, nil
+			}
+			occurrenceStart = start
+		case "day":
+			occurrenceStart = start.AddDate(0, 0, i)
+		case "week":
+			occurrenceStart = start.AddDate(0, 0, 7*i)
+		case "2 weeks":
+			occurrenceStart = start.AddDate(0, 0, 14*i)
+		case "3 weeks":
+			occurrenceStart = start.AddDate(0, 0, 21*i)
+		case "month":
+			occurrenceStart = addMonths(start, i)
+		default:
+			return nil, fmt.Errorf("unsupported repeat frequency %q", frequency)
+		}
+
+		if occurrenceStart.After(repeatTill) || !occurrenceStart.Before(till) {
+			return occurrences, nil
+		}
+
+		occurrenceEnd := occurrenceStart.Add(length)
+		if occurrenceEnd.After(from) {
+			if len(occurrences) == maxMaintenanceOccurrences {
+				return nil, fmt.Errorf("the range is too large, it covers more than %d occurrences of the maintenance", maxMaintenanceOccurrences)
+			}
+			occurrences = append(occurrences, MaintenanceOccurrence{
+				Start: occurrenceStart,
+				End:   occurrenceEnd,
+			})
+		}
+	}
+}
+
+// addMonths adds months to t, clamping the day to the last day of the resulting month, so that a
+// window on January 31 repeats on the last day of February rather than early in March.
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	first := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+
+	return first.AddDate(0, 0, min(day, lastDay)-1)
+}
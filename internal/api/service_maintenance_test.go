@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServiceMaintenanceWindowOccurrences(t *testing.T) {
+	window := &ServiceMaintenanceWindow{
+		From:        "2024-01-01T22:00:00Z",
+		Till:        "2024-01-02T02:00:00Z",
+		RepeatTill:  "2099-01-01T00:00:00Z",
+		RepeatDaily: true,
+	}
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	occurrences, err := window.Occurrences(from, from.AddDate(0, 0, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The occurrence of the last day of February ends within the range.
+	if len(occurrences) != 4 {
+		t.Fatalf("got %d occurrences, want 4", len(occurrences))
+	}
+	if want := time.Date(2024, 2, 29, 22, 0, 0, 0, time.UTC); !occurrences[0].Start.Equal(want) {
+		t.Errorf("got first start %s, want %s", occurrences[0].Start, want)
+	}
+
+	_, err = window.Occurrences(from, from.AddDate(100, 0, 0))
+	if err == nil || !strings.Contains(err.Error(), "the range is too large") {
+		t.Errorf("got error %v, want the range to be too large", err)
+	}
+}
+
+func TestServiceMaintenanceWindowMonthlyOccurrences(t *testing.T) {
+	window := &ServiceMaintenanceWindow{
+		From:          "2024-01-31T22:00:00Z",
+		Till:          "2024-01-31T23:00:00Z",
+		RepeatTill:    "2024-12-31T23:00:00Z",
+		RepeatMonthly: true,
+	}
+
+	occurrences, err := window.Occurrences(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"2024-01-31", "2024-02-29", "2024-03-31", "2024-04-30", "2024-05-31", "2024-06-30", "2024-07-31", "2024-08-31", "2024-09-30", "2024-10-31", "2024-11-30", "2024-12-31"}
+	if len(occurrences) != len(want) {
+		t.Fatalf("got %d occurrences, want %d", len(occurrences), len(want))
+	}
+	for i, occurrence := range occurrences {
+		if got := occurrence.Start.Format(time.DateOnly); got != want[i] {
+			t.Errorf("occurrence %d: got %s, want %s", i, got, want[i])
+		}
+		if occurrence.End.Sub(occurrence.Start) != time.Hour {
+			t.Errorf("occurrence %d: got length %s, want 1h", i, occurrence.End.Sub(occurrence.Start))
+		}
+	}
+}
+
+func TestAddServiceMaintenanceWindow(t *testing.T) {
+	var posted []UpdateServiceMaintenanceWindowsWindow
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/services/s1/maintenance" {
+			t.Errorf("got %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"data": [{"maintenance_from": "2032-06-01T10:30:00.000Z", "maintenance_till": "2032-06-01T11:30:00.000Z", "repeat_till": "2032-06-01T11:30:00.000Z"}]}`))
+		case http.MethodPost:
+			var req UpdateServiceMaintenanceWindows
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+			}
+			posted = req.Data.ServiceMaintenanceWindows
+			w.Write([]byte(`{"data": null}`))
+		}
+	})
+
+	err := client.AddServiceMaintenanceWindow(context.Background(), "s1", &ServiceMaintenanceWindow{From: "2032-06-01T10:30:00Z", Till: "2032-06-01T11:30:00Z"})
+	if !errors.Is(err, ErrMaintenanceWindowExists) {
+		t.Fatalf("got error %v, want %v", err, ErrMaintenanceWindowExists)
+	}
+	if posted != nil {
+		t.Fatalf("the identical window was posted: %+v", posted)
+	}
+
+	err = client.AddServiceMaintenanceWindow(context.Background(), "s1", &ServiceMaintenanceWindow{From: "2032-07-01T10:30:00Z", Till: "2032-07-01T11:30:00Z", RepeatTill: "2032-12-31T00:00:00Z", RepeatFrequency: "week"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posted) != 2 || posted[0].From != "2032-06-01T10:30:00.000Z" || posted[1].From != "2032-07-01T10:30:00Z" || !posted[1].Weekly {
+		t.Errorf("got windows %+v, want the existing and the added window", posted)
+	}
+}
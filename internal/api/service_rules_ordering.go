@@ -0,0 +1,171 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// Supported values for the rule_type of a service rule ordering.
+const (
+	ServiceRuleTypeRouting       = "routing"
+	ServiceRuleTypeSuppression   = "suppression"
+	ServiceRuleTypeTagging       = "tagging"
+	ServiceRuleTypeDeduplication = "deduplication"
+)
+
+var ServiceRuleTypes = []string{
+	ServiceRuleTypeRouting,
+	ServiceRuleTypeSuppression,
+	ServiceRuleTypeTagging,
+	ServiceRuleTypeDeduplication,
+}
+
+type ServiceRulesOrdering struct {
+	ServiceID string   `json:"service_id" tf:"service_id"`
+	RuleType  string   `json:"rule_type" tf:"rule_type"`
+	Ordering  []string `json:"ordering" tf:"ordering"`
+}
+
+func (o *ServiceRulesOrdering) Encode() (tf.M, error) {
+	return tf.Encode(o)
+}
+
+func (client *Client) GetServiceRulesOrdering(ctx context.Context, serviceID, teamID, ruleType string) (*ServiceRulesOrdering, error) {
+	ordering := &ServiceRulesOrdering{
+		ServiceID: serviceID,
+		RuleType:  ruleType,
+		Ordering:  []string{},
+	}
+
+	switch ruleType {
+	case ServiceRuleTypeRouting:
+		rules, err := client.GetRoutingRules(ctx, serviceID, teamID)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rules.Rules {
+			ordering.Ordering = append(ordering.Ordering, r.ID)
+		}
+	case ServiceRuleTypeSuppression:
+		rules, err := client.GetSuppressionRules(ctx, serviceID, teamID)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rules.Rules {
+			ordering.Ordering = append(ordering.Ordering, r.ID)
+		}
+	case ServiceRuleTypeTagging:
+		rules, err := client.GetTaggingRules(ctx, serviceID, teamID)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rules.Rules {
+			ordering.Ordering = append(ordering.Ordering, r.ID)
+		}
+	case ServiceRuleTypeDeduplication:
+		rules, err := client.GetDeduplicationRules(ctx, serviceID, teamID)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rules.Rules {
+			ordering.Ordering = append(ordering.Ordering, r.ID)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported rule type %q", ruleType)
+	}
+
+	return ordering, nil
+}
+
+// UpdateServiceRulesOrdering rewrites the rules of the given type in the order of the given rule ids.
+// The service rules endpoints do not expose a reorder call, so the current rules are fetched and
+// posted back in the requested order with their ids intact.
+func (client *Client) UpdateServiceRulesOrdering(ctx context.Context, serviceID, teamID, ruleType string, ordering []string) (*ServiceRulesOrdering, error) {
+	switch ruleType {
+	case ServiceRuleTypeRouting:
+		rules, err := client.GetRoutingRules(ctx, serviceID, teamID)
+		if err != nil {
+			return nil, err
+		}
+		ordered, err := reorderRules(rules.Rules, func(r *RoutingRule) string { return r.ID }, ordering)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = client.UpdateRoutingRules(ctx, serviceID, teamID, &UpdateRoutingRulesReq{Rules: ordered}); err != nil {
+			return nil, err
+		}
+	case ServiceRuleTypeSuppression:
+		rules, err := client.GetSuppressionRules(ctx, serviceID, teamID)
+		if err != nil {
+			return nil, err
+		}
+		ordered, err := reorderRules(rules.Rules, func(r *SuppressionRule) string { return r.ID }, ordering)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = client.UpdateSuppressionRules(ctx, serviceID, teamID, &UpdateSuppressionRulesReq{Rules: ordered}); err != nil {
+			return nil, err
+		}
+	case ServiceRuleTypeTagging:
+		rules, err := client.GetTaggingRules(ctx, serviceID, teamID)
+		if err != nil {
+			return nil, err
+		}
+		ordered, err := reorderRules(rules.Rules, func(r *TaggingRule) string { return r.ID }, ordering)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = client.UpdateTaggingRules(ctx, serviceID, teamID, &UpdateTaggingRulesReq{Rules: ordered}); err != nil {
+			return nil, err
+		}
+	case ServiceRuleTypeDeduplication:
+		rules, err := client.GetDeduplicationRules(ctx, serviceID, teamID)
+		if err != nil {
+			return nil, err
+		}
+		ordered, err := reorderRules(rules.Rules, func(r *DeduplicationRule) string { return r.ID }, ordering)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = client.UpdateDeduplicationRules(ctx, serviceID, teamID, &UpdateDeduplicationRulesReq{Rules: ordered}); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported rule type %q", ruleType)
+	}
+
+	return client.GetServiceRulesOrdering(ctx, serviceID, teamID, ruleType)
+}
+
+// reorderRules returns the rules sorted by the given ordering. The ordering must list every
+// existing rule exactly once, otherwise rules could silently be dropped from the service.
+func reorderRules[T any](rules []*T, id func(*T) string, ordering []string) ([]T, error) {
+	byID := make(map[string]*T, len(rules))
+	for _, r := range rules {
+		byID[id(r)] = r
+	}
+
+	ordered := make([]T, 0, len(ordering))
+	seen := make(map[string]bool, len(ordering))
+	for _, ruleID := range ordering {
+		r, ok := byID[ruleID]
+		if !ok {
+			return nil, fmt.Errorf("rule %s does not exist on this service", ruleID)
+		}
+		if seen[ruleID] {
+			return nil, fmt.Errorf("rule %s is listed more than once in the ordering", ruleID)
+		}
+		seen[ruleID] = true
+		ordered = append(ordered, *r)
+	}
+
+	for _, r := range rules {
+		if !seen[id(r)] {
+			return nil, fmt.Errorf("rule %s is missing from the ordering, all rules of the service must be listed", id(r))
+		}
+	}
+
+	return ordered, nil
+}
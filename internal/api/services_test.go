@@ -0,0 +1,22 @@
+package api
+
+import (
+	"context"
+	"testing"
+)
+
+func TestListServices(t *testing.T) {
+	client := newTestClient(t, serveGet(t, "/v3/services", "owner_id=team1",
+		`{"data": [{"id": "s1", "name": "payments", "owner": {"id": "team1", "type": "team"}}, {"id": "s2", "name": "checkout"}]}`))
+
+	services, err := client.ListServices(context.Background(), "team1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(services) != 2 || services[0].ID != "s1" || services[0].Name != "payments" || services[1].ID != "s2" {
+		t.Fatalf("got %+v", services)
+	}
+	if services[0].Owner.ID != "team1" {
+		t.Errorf("got owner %q, want %q", services[0].Owner.ID, "team1")
+	}
+}
@@ -0,0 +1,22 @@
+package api
+
+import (
+	"context"
+	"testing"
+)
+
+func TestListSquads(t *testing.T) {
+	client := newTestClient(t, serveGet(t, "/v4/squads", "team_id=team1",
+		`{"data": [{"id": "q1", "name": "database", "team_id": "team1", "members": [{"user_id": "u1", "role": "owner"}]}]}`))
+
+	squads, err := client.ListSquads(context.Background(), "team1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(squads) != 1 || squads[0].ID != "q1" || squads[0].Name != "database" || squads[0].TeamID != "team1" {
+		t.Fatalf("got %+v", squads)
+	}
+	if len(squads[0].Members) != 1 || squads[0].Members[0].UserID != "u1" {
+		t.Errorf("got members %+v", squads[0].Members)
+	}
+}
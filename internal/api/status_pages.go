@@ -41,12 +41,14 @@ type ThemeColor struct {
 }
 
 type StatusPageComponent struct {
-	ID             uint   `json:"id,omitempty" tf:"id"`
-	PageID         uint   `json:"pageID" tf:"status_page_id"`
-	Name           string `json:"name" tf:"name"`
-	Description    string `json:"description,omitempty" tf:"description"`
-	GroupID        *uint  `json:"groupID,omitempty" tf:"group_id"`
-	BelongsToGroup *bool  `json:"belongsToGroup" tf:"-"`
+	ID             uint     `json:"id,omitempty" tf:"id"`
+	PageID         uint     `json:"pageID" tf:"status_page_id"`
+	Name           string   `json:"name" tf:"name"`
+	Description    string   `json:"description,omitempty" tf:"description"`
+	GroupID        *uint    `json:"groupID,omitempty" tf:"group_id"`
+	BelongsToGroup *bool    `json:"belongsToGroup" tf:"-"`
+	ServiceIDs     []string `json:"serviceIDs" tf:"service_ids"`
+	ImpactStatusID uint     `json:"impactStatusID,omitempty" tf:"impact_status_id"`
 }
 
 type StatusPageGroup struct {
@@ -55,6 +57,39 @@ type StatusPageGroup struct {
 	Name   string `json:"name" tf:"name"`
 }
 
+// StatusPageStatus is a status of the issues of a status page, e.g. "Investigating".
+type StatusPageStatus struct {
+	ID     uint   `json:"id,omitempty" tf:"id"`
+	PageID uint   `json:"pageID" tf:"status_page_id"`
+	Name   string `json:"name" tf:"name"`
+	Color  string `json:"color" tf:"color"`
+}
+
+// StatusPageComponentStatus is an impact level of the components of a status page, e.g. "Major Outage".
+type StatusPageComponentStatus struct {
+	ID    uint   `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// StatusPageMaintenance is a scheduled maintenance published on a status page.
+type StatusPageMaintenance struct {
+	ID                uint                              `json:"id,omitempty" tf:"id"`
+	PageID            uint                              `json:"pageID" tf:"status_page_id"`
+	Title             string                            `json:"title" tf:"title"`
+	Description       string                            `json:"description" tf:"description"`
+	StartTime         string                            `json:"startTime" tf:"start_time"`
+	EndTime           string                            `json:"endTime" tf:"end_time"`
+	Components        []*StatusPageMaintenanceComponent `json:"components" tf:"-"`
+	NotifySubscribers bool                              `json:"notifySubscribers" tf:"-"`
+}
+
+// StatusPageMaintenanceComponent is a component affected by a maintenance, with its impact.
+type StatusPageMaintenanceComponent struct {
+	ComponentID    uint `json:"componentID" tf:"-"`
+	ImpactStatusID uint `json:"impactStatusID" tf:"impact_status_id"`
+}
+
 func (sp *StatusPage) Encode() (map[string]interface{}, error) {
 	m, err := tf.Encode(sp)
 	if err != nil {
@@ -103,6 +138,45 @@ func (spg *StatusPageGroup) Encode() (map[string]interface{}, error) {
 	return m, nil
 }
 
+func (sps *StatusPageStatus) Encode() (map[string]interface{}, error) {
+	m, err := tf.Encode(sps)
+	if err != nil {
+		return nil, err
+	}
+
+	m["status_page_id"] = strconv.FormatUint(uint64(sps.PageID), 10)
+
+	return m, nil
+}
+
+func (spm *StatusPageMaintenance) Encode() (map[string]interface{}, error) {
+	m, err := tf.Encode(spm)
+	if err != nil {
+		return nil, err
+	}
+
+	m["status_page_id"] = strconv.FormatUint(uint64(spm.PageID), 10)
+
+	components, err := tf.EncodeSlice(spm.Components)
+	if err != nil {
+		return nil, err
+	}
+	m["components"] = components
+
+	return m, nil
+}
+
+func (spmc *StatusPageMaintenanceComponent) Encode() (map[string]interface{}, error) {
+	m, err := tf.Encode(spmc)
+	if err != nil {
+		return nil, err
+	}
+
+	m["component_id"] = strconv.FormatUint(uint64(spmc.ComponentID), 10)
+
+	return m, nil
+}
+
 func (client *Client) CreateStatusPage(ctx context.Context, req *StatusPage) (*StatusPage, error) {
 	url := fmt.Sprintf("%s/statuspages", client.BaseURLV4)
 	data, err := Request[StatusPage, StatusPage](http.MethodPost, url, client, ctx, req)
@@ -188,3 +262,55 @@ func (client *Client) DeleteStatusPageGroup(ctx context.Context, pageID, groupID
 	url := fmt.Sprintf("%s/statuspages/%s/groups/%s", client.BaseURLV4, pageID, groupID)
 	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
 }
+
+// Status Page Status APIs
+func (client *Client) ListStatusPageStatuses(ctx context.Context, pageID string) ([]*StatusPageStatus, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/statuses", client.BaseURLV4, pageID)
+	return RequestSlice[any, StatusPageStatus](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) CreateStatusPageStatus(ctx context.Context, pageID string, req *StatusPageStatus) (*StatusPageStatus, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/statuses", client.BaseURLV4, pageID)
+	return Request[StatusPageStatus, StatusPageStatus](http.MethodPost, url, client, ctx, req)
+}
+
+func (client *Client) GetStatusPageStatusById(ctx context.Context, pageID, statusID string) (*StatusPageStatus, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/statuses/%s", client.BaseURLV4, pageID, statusID)
+	return Request[any, StatusPageStatus](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) UpdateStatusPageStatus(ctx context.Context, pageID, statusID string, req *StatusPageStatus) (*StatusPageStatus, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/statuses/%s", client.BaseURLV4, pageID, statusID)
+	return Request[StatusPageStatus, StatusPageStatus](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeleteStatusPageStatus(ctx context.Context, pageID, statusID string) (*any, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/statuses/%s", client.BaseURLV4, pageID, statusID)
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
+
+func (client *Client) ListStatusPageComponentStatuses(ctx context.Context, pageID string) ([]*StatusPageComponentStatus, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/component-statuses", client.BaseURLV4, pageID)
+	return RequestSlice[any, StatusPageComponentStatus](http.MethodGet, url, client, ctx, nil)
+}
+
+// Status Page Maintenance APIs
+func (client *Client) CreateStatusPageMaintenance(ctx context.Context, pageID string, req *StatusPageMaintenance) (*StatusPageMaintenance, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/maintenances", client.BaseURLV4, pageID)
+	return Request[StatusPageMaintenance, StatusPageMaintenance](http.MethodPost, url, client, ctx, req)
+}
+
+func (client *Client) GetStatusPageMaintenanceById(ctx context.Context, pageID, maintenanceID string) (*StatusPageMaintenance, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/maintenances/%s", client.BaseURLV4, pageID, maintenanceID)
+	return Request[any, StatusPageMaintenance](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) UpdateStatusPageMaintenance(ctx context.Context, pageID, maintenanceID string, req *StatusPageMaintenance) (*StatusPageMaintenance, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/maintenances/%s", client.BaseURLV4, pageID, maintenanceID)
+	return Request[StatusPageMaintenance, StatusPageMaintenance](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeleteStatusPageMaintenance(ctx context.Context, pageID, maintenanceID string) (*any, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/maintenances/%s", client.BaseURLV4, pageID, maintenanceID)
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
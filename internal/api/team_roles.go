@@ -9,10 +9,18 @@ import (
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
+// ListTeamRoles lists the roles of a team. The list is cached by the client, until a role of the
+// team is changed through it.
 func (client *Client) ListTeamRoles(ctx context.Context, teamID string) ([]*TeamRole, error) {
 	url := fmt.Sprintf("%s/teams/%s/roles?owner_id=%s", client.BaseURLV3, teamID, teamID)
 
-	return RequestSlice[any, TeamRole](http.MethodGet, url, client, ctx, nil)
+	return cached(ctx, &client.catalog, teamRolesCacheKey(teamID), func(ctx context.Context) ([]*TeamRole, error) {
+		return RequestSlice[any, TeamRole](http.MethodGet, url, client, ctx, nil)
+	})
+}
+
+func teamRolesCacheKey(teamID string) string {
+	return "team-roles/" + teamID
 }
 
 func (client *Client) GetTeamRoleByID(ctx context.Context, teamID string, id string) (*TeamRole, error) {
@@ -76,6 +84,7 @@ func (client *Client) CreateTeamRole(ctx context.Context, teamID string, req *Cr
 	payload["abilities"] = decodeAbilities(req.Abilities)
 
 	_, err := Request[tf.M, Team](http.MethodPost, url, client, ctx, &payload)
+	client.catalog.invalidate(teamRolesCacheKey(teamID))
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +100,7 @@ func (client *Client) UpdateTeamRole(ctx context.Context, teamID string, id stri
 	payload["abilities"] = decodeAbilities(req.Abilities)
 
 	_, err := Request[tf.M, Team](http.MethodPut, url, client, ctx, &payload)
+	client.catalog.invalidate(teamRolesCacheKey(teamID))
 	if err != nil {
 		return nil, err
 	}
@@ -101,5 +111,6 @@ func (client *Client) UpdateTeamRole(ctx context.Context, teamID string, id stri
 func (client *Client) DeleteTeamRole(ctx context.Context, teamID string, id string) (*any, error) {
 	url := fmt.Sprintf("%s/teams/%s/roles/%s", client.BaseURLV3, teamID, id)
 
+	defer client.catalog.invalidate(teamRolesCacheKey(teamID))
 	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
 }
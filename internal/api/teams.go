@@ -109,5 +109,6 @@ func (client *Client) GetTeamById(ctx context.Context, id string) (*Team, error)
 func (client *Client) DeleteTeam(ctx context.Context, id string) (*any, error) {
 	url := fmt.Sprintf("%s/teams/%s", client.BaseURLV3, id)
 
+	defer client.catalog.invalidate(teamRolesCacheKey(id))
 	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
 }
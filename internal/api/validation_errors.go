@@ -0,0 +1,159 @@
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FieldError is an error of a field of a request rejected by the API.
+type FieldError struct {
+	// Field is the field as named by the API, e.g. "rules[1].escalationTime".
+	Field   string
+	Message string
+	// Path is the path of the attribute of the field, e.g. ["rules", 1, "delay_minutes"], made of
+	// attribute names and list indexes. It is empty when the field could not be mapped to an attribute.
+	Path []any
+}
+
+// ValidationErrors are the field errors of an error response. The API returns them either as an
+// object of messages by field, or as a list of objects with a field and a message.
+type ValidationErrors []*FieldError
+
+func (errs *ValidationErrors) UnmarshalJSON(b []byte) error {
+	var byField map[string]json.RawMessage
+	if err := json.Unmarshal(b, &byField); err == nil {
+		fields := make([]string, 0, len(byField))
+		for field := range byField {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			for _, message := range validationMessages(byField[field]) {
+				*errs = append(*errs, &FieldError{Field: field, Message: message})
+			}
+		}
+		return nil
+	}
+
+	var list []map[string]any
+	if err := json.Unmarshal(b, &list); err == nil {
+		for _, item := range list {
+			*errs = append(*errs, &FieldError{
+				Field:   firstString(item, "field", "path", "key", "param"),
+				Message: firstString(item, "message", "error", "msg", "reason"),
+			})
+		}
+		return nil
+	}
+
+	*errs = append(*errs, &FieldError{Message: string(b)})
+	return nil
+}
+
+func (errs ValidationErrors) String() string {
+	lines := make([]string, 0, len(errs))
+	for _, err := range errs {
+		if err.Field == "" {
+			lines = append(lines, err.Message)
+		} else {
+			lines = append(lines, err.Field+": "+err.Message)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// validationMessages returns the messages of a field, which are either a string or a list of strings.
+func validationMessages(raw json.RawMessage) []string {
+	var message string
+	if err := json.Unmarshal(raw, &message); err == nil {
+		return []string{message}
+	}
+
+	var messages []string
+	if err := json.Unmarshal(raw, &messages); err == nil {
+		return messages
+	}
+
+	return []string{string(raw)}
+}
+
+func firstString(m map[string]any, keys ...string) string {
+	for _, key := range keys {
+		if s, ok := m[key].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// AttributePath returns the path of the attribute of a field of a request of type t, following the
+// json tags of the fields to their tfpath tags, or to their tf tags if they have none. A tfpath tag
+// may name a nested attribute, e.g. "repeat.0.times", and several fields may name the same one. The
+// path ends at the last field which has neither tag.
+func AttributePath(t reflect.Type, field string) []any {
+	segments := strings.FieldsFunc(field, func(r rune) bool {
+		return r == '.' || r == '[' || r == ']' || r == '/'
+	})
+
+	var path []any
+	for _, segment := range segments {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+
+		if index, err := strconv.Atoi(segment); err == nil {
+			if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+				break
+			}
+			path = append(path, index)
+			t = t.Elem()
+			continue
+		}
+
+		if t.Kind() != reflect.Struct {
+			break
+		}
+		f, ok := fieldByJSONName(t, segment)
+		if !ok {
+			break
+		}
+		tag, ok := f.Tag.Lookup("tfpath")
+		if !ok {
+			tag = f.Tag.Get("tf")
+		}
+		if tag == "" || tag == "-" {
+			break
+		}
+		for _, name := range strings.Split(tag, ".") {
+			if index, err := strconv.Atoi(name); err == nil {
+				path = append(path, index)
+			} else {
+				path = append(path, name)
+			}
+		}
+		t = f.Type
+	}
+
+	return path
+}
+
+// fieldByJSONName returns the field of the struct t, or of its embedded structs, with the json name.
+func fieldByJSONName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		jsonName, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if jsonName == name {
+			return f, true
+		}
+		if f.Anonymous && jsonName == "" && f.Type.Kind() == reflect.Struct {
+			if embedded, ok := fieldByJSONName(f.Type, name); ok {
+				return embedded, true
+			}
+		}
+	}
+	return reflect.StructField{}, false
+}
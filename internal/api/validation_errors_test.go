@@ -0,0 +1,31 @@
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAttributePath(t *testing.T) {
+	cases := map[string]struct {
+		req   any
+		field string
+		want  []any
+	}{
+		"nested attribute":  {req: CreateUpdateEscalationPolicyReq{}, field: "rules[1].roundrobin_enabled", want: []any{"rules", 1, "round_robin", 0, "enabled"}},
+		"shared attribute":  {req: CreateUpdateEscalationPolicyReq{}, field: "rules.0.entities.2.pid", want: []any{"rules", 0, "targets", 2, "id"}},
+		"tf tag":            {req: WebformReq{}, field: "services/0/alias", want: []any{"services", 0, "alias"}},
+		"several fields":    {req: WebformReq{}, field: "is_cname", want: []any{"custom_domain_name"}},
+		"untagged field":    {req: CreateUpdateEscalationPolicyReq{}, field: "is_using_new_fields", want: nil},
+		"unknown field":     {req: WebformReq{}, field: "owner.id", want: nil},
+		"index of non list": {req: WebformReq{}, field: "name.0", want: []any{"name"}},
+		"nested tf tag":     {req: CreateUpdateEscalationPolicyReq{}, field: "entity_owner.type", want: []any{"entity_owner", "type"}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := AttributePath(reflect.TypeOf(tc.req), tc.field); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %#v, want %#v", got, tc.want)
+			}
+		})
+	}
+}
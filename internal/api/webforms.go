@@ -9,23 +9,24 @@ import (
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
+// WebformReq is tagged with the attributes of its fields, so that the errors of the API name them.
 type WebformReq struct {
-	TeamID        string            `json:"owner_id"`
-	Name          string            `json:"name"`
-	IsCname       bool              `json:"is_cname"`
-	PublicUrl     string            `json:"public_url"`
-	HostName      string            `json:"host_name"`
-	Tags          map[string]string `json:"tags"`
-	FormOwnerType string            `json:"form_owner_type"`
-	FormOwnerID   string            `json:"form_owner_id"`
-	Services      []WFService       `json:"services"`
-	InputField    []WFInputField    `json:"input_field"`
-	Header        string            `json:"header"`
-	Title         string            `json:"title"`
-	FooterText    string            `json:"footer_text"`
-	FooterLink    string            `json:"footer_link"`
-	EmailOn       []string          `json:"email_on"`
-	Description   string            `json:"description"`
+	TeamID        string            `json:"owner_id" tfpath:"team_id"`
+	Name          string            `json:"name" tfpath:"name"`
+	IsCname       bool              `json:"is_cname" tfpath:"custom_domain_name"`
+	PublicUrl     string            `json:"public_url" tfpath:"public_url"`
+	HostName      string            `json:"host_name" tfpath:"custom_domain_name"`
+	Tags          map[string]string `json:"tags" tfpath:"tags"`
+	FormOwnerType string            `json:"form_owner_type" tfpath:"owner.0.type"`
+	FormOwnerID   string            `json:"form_owner_id" tfpath:"owner.0.id"`
+	Services      []WFService       `json:"services" tfpath:"services"`
+	InputField    []WFInputField    `json:"input_field" tfpath:"input_field"`
+	Header        string            `json:"header" tfpath:"header"`
+	Title         string            `json:"title" tfpath:"title"`
+	FooterText    string            `json:"footer_text" tfpath:"footer_text"`
+	FooterLink    string            `json:"footer_link" tfpath:"footer_link"`
+	EmailOn       []string          `json:"email_on" tfpath:"email_on"`
+	Description   string            `json:"description" tfpath:"description"`
 }
 
 type Webform struct {
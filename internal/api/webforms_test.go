@@ -0,0 +1,19 @@
+package api
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetWebformByName(t *testing.T) {
+	client := newTestClient(t, serveGet(t, "/v3/webform/by-name", "name=status+form&owner_id=team1",
+		`{"data": {"id": 42, "owner_id": "team1", "name": "status form", "host_name": "status.example.com"}}`))
+
+	webform, err := client.GetWebformByName(context.Background(), "team1", "status form")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if webform.ID != 42 || webform.Name != "status form" || webform.TeamID != "team1" || webform.HostName != "status.example.com" {
+		t.Fatalf("got %+v", webform)
+	}
+}
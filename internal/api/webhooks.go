@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// WebhookTrigger is an event which triggers an automatic webhook.
+type WebhookTrigger struct {
+	EventClass string `json:"event_class" tf:"event_class"`
+	EventType  string `json:"event_type" tf:"event_type"`
+}
+
+func (t *WebhookTrigger) Encode() (tf.M, error) {
+	return tf.Encode(t)
+}
+
+// WebhookHeader is a header sent with the webhook requests.
+type WebhookHeader struct {
+	Key   string `json:"key" tf:"key"`
+	Value string `json:"value" tf:"value"`
+}
+
+func (h *WebhookHeader) Encode() (tf.M, error) {
+	return tf.Encode(h)
+}
+
+type Webhook struct {
+	ID                     string            `json:"id" tf:"id"`
+	Name                   string            `json:"name" tf:"name"`
+	Description            string            `json:"description" tf:"description"`
+	OwnerID                string            `json:"owner_id" tf:"team_id"`
+	Type                   string            `json:"type" tf:"type"`
+	URL                    string            `json:"url" tf:"url"`
+	Triggers               []*WebhookTrigger `json:"triggers" tf:"-"`
+	Headers                []*WebhookHeader  `json:"headers" tf:"-"`
+	IsCustomPayloadEnabled bool              `json:"is_custom_payload_enabled" tf:"-"`
+	CustomPayload          string            `json:"custom_payload" tf:"payload_template"`
+	EntityOwner            *EntityOwner      `json:"entity_owner" tf:"-"`
+}
+
+func (w *Webhook) Encode() (tf.M, error) {
+	m, err := tf.Encode(w)
+	if err != nil {
+		return nil, err
+	}
+
+	triggers, err := tf.EncodeSlice(w.Triggers)
+	if err != nil {
+		return nil, err
+	}
+	m["triggers"] = triggers
+
+	headers, err := tf.EncodeSlice(w.Headers)
+	if err != nil {
+		return nil, err
+	}
+	m["headers"] = headers
+
+	if !w.IsCustomPayloadEnabled {
+		m["payload_template"] = ""
+	}
+
+	if w.EntityOwner != nil {
+		m["entity_owner"] = tf.List(tf.M{
+			"id":   w.EntityOwner.ID,
+			"type": w.EntityOwner.Type,
+		})
+	}
+
+	return m, nil
+}
+
+type CreateUpdateWebhookReq struct {
+	Name                   string            `json:"name"`
+	Description            string            `json:"description"`
+	OwnerID                string            `json:"owner_id"`
+	Type                   string            `json:"type"`
+	URL                    string            `json:"url"`
+	Triggers               []*WebhookTrigger `json:"triggers"`
+	Headers                []*WebhookHeader  `json:"headers"`
+	IsCustomPayloadEnabled bool              `json:"is_custom_payload_enabled"`
+	CustomPayload          string            `json:"custom_payload,omitempty"`
+	EntityOwner            *EntityOwner      `json:"entity_owner,omitempty"`
+}
+
+func (client *Client) GetWebhookById(ctx context.Context, id string) (*Webhook, error) {
+	url := fmt.Sprintf("%s/webhooks/%s", client.BaseURLV3, id)
+
+	return Request[any, Webhook](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) GetWebhookByName(ctx context.Context, teamID string, name string) (*Webhook, error) {
+	webhooks, err := client.ListWebhooks(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, w := range webhooks {
+		if w.Name == name {
+			return w, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find a webhook with name `%s`", name)
+}
+
+func (client *Client) ListWebhooks(ctx context.Context, teamID string) ([]*Webhook, error) {
+	url := fmt.Sprintf("%s/webhooks?owner_id=%s", client.BaseURLV3, teamID)
+
+	return RequestSlice[any, Webhook](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) CreateWebhook(ctx context.Context, req *CreateUpdateWebhookReq) (*Webhook, error) {
+	url := fmt.Sprintf("%s/webhooks", client.BaseURLV3)
+
+	return Request[CreateUpdateWebhookReq, Webhook](http.MethodPost, url, client, ctx, req)
+}
+
+func (client *Client) UpdateWebhook(ctx context.Context, id string, req *CreateUpdateWebhookReq) (*Webhook, error) {
+	url := fmt.Sprintf("%s/webhooks/%s", client.BaseURLV3, id)
+
+	return Request[CreateUpdateWebhookReq, Webhook](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeleteWebhook(ctx context.Context, id string) (*any, error) {
+	url := fmt.Sprintf("%s/webhooks/%s", client.BaseURLV3, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
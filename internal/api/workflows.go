@@ -28,23 +28,23 @@ type Workflow struct {
 }
 
 type HighLevelFilter struct {
-	Condition string        `json:"condition" tf:"condition"`
-	Filters   []FilterGroup `json:"filters" tf:"filters"`
+	Condition string         `json:"condition" tf:"condition"`
+	Filters   []*FilterGroup `json:"filters" tf:"filters"`
 }
 
+// FilterGroup is either a filter on a key, or a group of filters joined by the condition. Groups
+// may be nested.
 type FilterGroup struct {
-	Condition string          `json:"condition,omitempty" tf:"condition"`
-	Key       string          `json:"key" tf:"key"`
-	Type      string          `json:"type,omitempty" tf:"type"`
-	Value     string          `json:"value,omitempty" tf:"value"`
-	Filters   []*ChildFilters `json:"filters,omitempty" tf:"filters"`
+	Condition string         `json:"condition,omitempty" tf:"condition"`
+	Key       string         `json:"key" tf:"key"`
+	Type      string         `json:"type,omitempty" tf:"type"`
+	Value     string         `json:"value,omitempty" tf:"value"`
+	Filters   []*FilterGroup `json:"filters,omitempty" tf:"filters"`
 }
 
-type ChildFilters struct {
-	Type  string `json:"type" tf:"type"`
-	Key   string `json:"key" tf:"key"`
-	Value string `json:"value" tf:"value"`
-}
+// WorkflowFilterMaxDepth is the number of levels of filters, below the filters of the workflow,
+// which the provider supports.
+const WorkflowFilterMaxDepth = 5
 
 type WorkflowTag struct {
 	Value string `json:"value" tf:"value"`
@@ -52,14 +52,6 @@ type WorkflowTag struct {
 	Key   string `json:"key" tf:"key"`
 }
 
-func (f FilterGroup) Encode() (tf.M, error) {
-	return tf.Encode(f)
-}
-
-func (f *ChildFilters) Encode() (tf.M, error) {
-	return tf.Encode(f)
-}
-
 func (twc *WorkflowTag) Encode() (tf.M, error) {
 	return tf.Encode(twc)
 }
@@ -77,69 +69,15 @@ func (w *Workflow) Encode() (tf.M, error) {
 	m["tags"] = tagsEncoded
 
 	if w.Filters != nil {
-
-		filters := []tf.M{}
-
-		for _, filter := range w.Filters.Filters {
-
-			fData := tf.M{}
-			if filter.Condition != "" {
-				fData["condition"] = filter.Condition
-			}
-
-			if filter.Type != "" {
-				fData["type"] = filter.Type
-			}
-
-			if filter.Value != "" {
-				fData["value"] = filter.Value
-			}
-
-			if filter.Key != "" {
-				fData["key"] = filter.Key
-			}
-
-			if filter.Filters != nil {
-				childFilters := []tf.M{}
-				for _, childFilter := range filter.Filters {
-					childFilterData := tf.M{}
-					if childFilter.Type != "" {
-						childFilterData["type"] = childFilter.Type
-					}
-					if childFilter.Key != "" {
-						childFilterData["key"] = childFilter.Key
-					}
-					if childFilter.Value != "" {
-						childFilterData["value"] = childFilter.Value
-					}
-					childFilters = append(childFilters, childFilterData)
-				}
-				fData["filters"] = childFilters
-			}
-
-			filters = append(filters, fData)
+		filters, err := encodeFilterGroups(w.Filters.Filters, WorkflowFilterMaxDepth)
+		if err != nil {
+			return nil, err
 		}
 
-		// filter state format
-		// tf.List(tf.M{
-		// 	"condition": "",
-		// 	"filters": tf.List(tf.M{
-		// 		"condition": "",
-		// 		"type":      "",
-		// 		"value":     "",
-		// 		"filters": tf.List(tf.M{
-		// 			"type":  "",
-		// 			"key":   "",
-		// 			"value": "",
-		// 		}),
-		// 	}),
-		// })
-
 		m["filters"] = tf.List(tf.M{
 			"condition": w.Filters.Condition,
 			"filters":   filters,
 		})
-
 	}
 
 	m["entity_owner"] = tf.List(tf.M{
@@ -150,6 +88,42 @@ func (w *Workflow) Encode() (tf.M, error) {
 	return m, nil
 }
 
+// encodeFilterGroups encodes nested filters, omitting the empty fields. It fails if the filters are
+// nested deeper than depth levels.
+func encodeFilterGroups(filters []*FilterGroup, depth int) ([]tf.M, error) {
+	if depth == 0 {
+		return nil, fmt.Errorf("the filters of the workflow are nested deeper than the %d levels supported by the provider", WorkflowFilterMaxDepth)
+	}
+
+	encoded := make([]tf.M, 0, len(filters))
+	for _, filter := range filters {
+		fData := tf.M{}
+		if filter.Condition != "" {
+			fData["condition"] = filter.Condition
+		}
+		if filter.Type != "" {
+			fData["type"] = filter.Type
+		}
+		if filter.Key != "" {
+			fData["key"] = filter.Key
+		}
+		if filter.Value != "" {
+			fData["value"] = filter.Value
+		}
+		if len(filter.Filters) > 0 {
+			children, err := encodeFilterGroups(filter.Filters, depth-1)
+			if err != nil {
+				return nil, err
+			}
+			fData["filters"] = children
+		}
+
+		encoded = append(encoded, fData)
+	}
+
+	return encoded, nil
+}
+
 func (client *Client) CreateWorkflow(ctx context.Context, workflowReq *Workflow) (*Workflow, error) {
 	url := fmt.Sprintf("%s/workflows", client.BaseURLV3)
 	return Request[Workflow, Workflow](http.MethodPost, url, client, ctx, workflowReq)
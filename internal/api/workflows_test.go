@@ -0,0 +1,74 @@
+package api
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEncodeFilterGroups(t *testing.T) {
+	// nested returns a filter nested depth levels deep.
+	nested := func(depth int) []*FilterGroup {
+		filters := []*FilterGroup{{Type: "priority_is", Value: "P1"}}
+		for range depth - 1 {
+			filters = []*FilterGroup{{Condition: "and", Filters: filters}}
+		}
+		return filters
+	}
+
+	cases := map[string]struct {
+		filters []*FilterGroup
+		wantErr string
+	}{
+		"single level":     {filters: nested(1)},
+		"maximum depth":    {filters: nested(WorkflowFilterMaxDepth)},
+		"beyond max depth": {filters: nested(WorkflowFilterMaxDepth + 1), wantErr: "nested deeper than"},
+		"deep sibling": {
+			filters: append(nested(1), &FilterGroup{Condition: "or", Filters: nested(WorkflowFilterMaxDepth)}),
+			wantErr: "nested deeper than",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := encodeFilterGroups(tc.filters, WorkflowFilterMaxDepth)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestEncodeFilterGroupsOmitsEmptyFields(t *testing.T) {
+	filters := []*FilterGroup{
+		{Type: "priority_is", Value: "P1"},
+		{Condition: "or", Filters: []*FilterGroup{
+			{Type: "tag_is", Key: "env", Value: "prod"},
+			{Type: "tag_is", Key: "env", Value: "staging"},
+		}},
+	}
+
+	encoded, err := encodeFilterGroups(filters, WorkflowFilterMaxDepth)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(encoded) != 2 {
+		t.Fatalf("got %d filters, want 2", len(encoded))
+	}
+	if _, ok := encoded[0]["condition"]; ok {
+		t.Errorf("got condition %v for a filter without one", encoded[0]["condition"])
+	}
+	if _, ok := encoded[1]["type"]; ok {
+		t.Errorf("got type %v for a group", encoded[1]["type"])
+	}
+	children, ok := encoded[1]["filters"].([]map[string]any)
+	if !ok || len(children) != 2 || children[1]["value"] != "staging" {
+		t.Errorf("got nested filters %v, want the two tag filters", encoded[1]["filters"])
+	}
+}
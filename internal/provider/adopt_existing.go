@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+const adoptExistingDescription = "Adopt the existing %s with the same name when creating this one conflicts with it, e.g. when a previous apply created it but failed to save it to the state. The adopted %s is updated to match the configuration. Defaults to false."
+
+// adoptExistingSchema is the schema of adopt_existing, for resources whose names are unique.
+func adoptExistingSchema(entity string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf(adoptExistingDescription, entity, entity),
+		Type:        schema.TypeBool,
+		Optional:    true,
+	}
+}
+
+// adoptExisting returns the id of the existing object with the same name as the object whose creation
+// failed with err, looked up with lookup, if the creation conflicted with it on the name and adopt is
+// set.
+// It returns err otherwise.
+func adoptExisting(ctx context.Context, adopt bool, entity, name string, err error, lookup func() (string, error)) (string, error) {
+	if !adopt || !api.IsNameConflictError(err, name) {
+		return "", err
+	}
+
+	id, lookupErr := lookup()
+	if lookupErr != nil {
+		return "", fmt.Errorf("%w\n\nthe existing %s %q could not be adopted: %v", err, entity, name, lookupErr)
+	}
+
+	tflog.Info(ctx, "Adopting existing "+entity, tf.M{
+		"id":   id,
+		"name": name,
+	})
+
+	return id, nil
+}
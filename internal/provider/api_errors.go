@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+// diagFromAPIError returns the diagnostics of an error. When the API rejected fields of the request,
+// there is a diagnostic for each of them, pointing at the attribute of the field.
+func diagFromAPIError(err error) diag.Diagnostics {
+	var requestErr *api.RequestError
+	if !errors.As(err, &requestErr) || requestErr.ErrorDetails == nil || len(requestErr.ErrorDetails.Errors) == 0 {
+		return diag.FromErr(err)
+	}
+
+	summary := requestErr.Message
+	if summary == "" {
+		summary = "Invalid request"
+	}
+
+	var diags diag.Diagnostics
+	for _, fieldErr := range requestErr.ErrorDetails.Errors {
+		d := diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   fieldErr.Message,
+		}
+		if fieldErr.Field != "" {
+			d.Detail = fieldErr.Field + ": " + fieldErr.Message
+		}
+
+		for _, step := range fieldErr.Path {
+			switch step := step.(type) {
+			case string:
+				d.AttributePath = d.AttributePath.GetAttr(step)
+			case int:
+				d.AttributePath = d.AttributePath.IndexInt(step)
+			}
+		}
+
+		diags = append(diags, d)
+	}
+
+	return diags
+}
+
+// attributePathResource makes the attribute paths of the diagnostics of the create and update
+// functions of a resource, which return diagFromAPIError, point at attributes of its schema. The
+// paths of the fields of a request may go deeper than the schema, e.g. into the elements of a set,
+// which cannot be pointed at.
+func attributePathResource(r *schema.Resource) {
+	r.CreateContext = trimAttributePaths(r, r.CreateContext)
+	r.UpdateContext = trimAttributePaths(r, r.UpdateContext)
+}
+
+func trimAttributePaths[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](r *schema.Resource, f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+		for i := range diags {
+			if len(diags[i].AttributePath) > 0 {
+				diags[i].AttributePath = trimAttributePath(r.Schema, diags[i].AttributePath)
+			}
+		}
+		return diags
+	}
+}
+
+// trimAttributePath returns the longest prefix of path which is an attribute of the schema, or an
+// element of a list.
+func trimAttributePath(s map[string]*schema.Schema, path cty.Path) cty.Path {
+	var trimmed cty.Path
+	for i := 0; i < len(path); i++ {
+		attr, ok := path[i].(cty.GetAttrStep)
+		if !ok {
+			break
+		}
+		attrSchema, ok := s[attr.Name]
+		if !ok {
+			break
+		}
+		trimmed = append(trimmed, attr)
+
+		if attrSchema.Type != schema.TypeList || i+1 == len(path) {
+			break
+		}
+		index, ok := path[i+1].(cty.IndexStep)
+		if !ok {
+			break
+		}
+		trimmed = append(trimmed, index)
+		i++
+
+		elem, ok := attrSchema.Elem.(*schema.Resource)
+		if !ok {
+			break
+		}
+		s = elem.Schema
+	}
+
+	if len(trimmed) == 0 {
+		return nil
+	}
+	return trimmed
+}
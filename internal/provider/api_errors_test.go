@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestDiagFromAPIError(t *testing.T) {
+	cases := map[string]struct {
+		errors    string
+		wantPaths []cty.Path
+	}{
+		"messages by field": {
+			errors: `{"rules[1].escalationTime": "must be at most 1000", "name": ["is required"]}`,
+			wantPaths: []cty.Path{
+				cty.GetAttrPath("name"),
+				cty.GetAttrPath("rules").IndexInt(1).GetAttr("delay_minutes"),
+			},
+		},
+		"list of fields": {
+			errors: `[{"field": "rules.0.repetition", "message": "must be positive"}, {"field": "rules.0.via.1", "message": "is not a channel"}]`,
+			wantPaths: []cty.Path{
+				cty.GetAttrPath("rules").IndexInt(0).GetAttr("repeat").IndexInt(0).GetAttr("times"),
+				cty.GetAttrPath("rules").IndexInt(0).GetAttr("notification_channels"),
+			},
+		},
+		"unmapped field": {
+			errors:    `{"is_using_new_fields": "must be true", "unknown": "is not allowed"}`,
+			wantPaths: []cty.Path{nil, nil},
+		},
+	}
+
+	reqType := reflect.TypeOf(api.CreateUpdateEscalationPolicyReq{})
+	schema := resourceEscalationPolicy().Schema
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var fieldErrs api.ValidationErrors
+			if err := json.Unmarshal([]byte(tc.errors), &fieldErrs); err != nil {
+				t.Fatal(err)
+			}
+			for _, fieldErr := range fieldErrs {
+				fieldErr.Path = api.AttributePath(reqType, fieldErr.Field)
+			}
+
+			err := fmt.Errorf("creating: %w", &api.RequestError{AppError: &api.AppError{
+				Status:       422,
+				Message:      "validation failed",
+				ErrorDetails: &api.ErrorDetails{Code: "invalid", Errors: fieldErrs},
+			}})
+
+			diags := diagFromAPIError(err)
+			if len(diags) != len(tc.wantPaths) {
+				t.Fatalf("got %d diagnostics, want %d", len(diags), len(tc.wantPaths))
+			}
+			for i, d := range diags {
+				if d.Summary != "validation failed" {
+					t.Errorf("diagnostic %d: got summary %q", i, d.Summary)
+				}
+				got := trimAttributePath(schema, d.AttributePath)
+				if !got.Equals(tc.wantPaths[i]) {
+					t.Errorf("diagnostic %d (%s): got path %#v, want %#v", i, d.Detail, got, tc.wantPaths[i])
+				}
+			}
+		})
+	}
+}
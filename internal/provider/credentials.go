@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// credentialSources describes where the provider looks for credentials, in order. It is shown
+// when no credentials are found.
+const credentialSources = `The provider looks for credentials in this order:
+  1. refresh_token, or the SQUADCAST_REFRESH_TOKEN environment variable
+  2. refresh_token_file, or the SQUADCAST_REFRESH_TOKEN_FILE environment variable
+  3. credential_process, or the SQUADCAST_CREDENTIAL_PROCESS environment variable
+  4. the refresh_token or credential_process of the profile in the shared credentials file
+     (profile or SQUADCAST_PROFILE, "default" if unset; shared_credentials_file or
+     SQUADCAST_SHARED_CREDENTIALS_FILE, ~/.squadcast/credentials if unset)`
+
+// credentials are the credentials and region resolved from the configuration of the provider.
+type credentials struct {
+	RefreshToken string
+	// AccessToken is set instead of RefreshToken when a credential process returns an access token.
+	AccessToken string
+	// Region is the region of the profile, if any.
+	Region string
+	// Source describes where the credentials were found.
+	Source string
+}
+
+// credentialProcessOutput is the JSON printed by a credential process.
+type credentialProcessOutput struct {
+	RefreshToken string `json:"refresh_token"`
+	AccessToken  string `json:"access_token"`
+}
+
+// resolveCredentials returns the credentials from the first source which is set. It returns an error
+// listing the sources when none of them is set.
+func resolveCredentials(ctx context.Context, rd *schema.ResourceData) (*credentials, error) {
+	profile, err := readProfile(rd)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := &credentials{}
+	if profile != nil {
+		creds.Region = profile.Values["region"]
+	}
+
+	switch {
+	case rd.Get("refresh_token").(string) != "":
+		creds.RefreshToken = rd.Get("refresh_token").(string)
+		creds.Source = "refresh_token"
+	case rd.Get("refresh_token_file").(string) != "":
+		path := rd.Get("refresh_token_file").(string)
+		creds.RefreshToken, err = readRefreshTokenFile(path)
+		creds.Source = "refresh_token_file " + path
+	case rd.Get("credential_process").(string) != "":
+		err = runCredentialProcess(ctx, rd.Get("credential_process").(string), creds)
+		creds.Source = "credential_process"
+	case profile != nil && profile.Values["refresh_token"] != "":
+		creds.RefreshToken = profile.Values["refresh_token"]
+		creds.Source = fmt.Sprintf("profile %q of %s", profile.Name, profile.Path)
+	case profile != nil && profile.Values["credential_process"] != "":
+		err = runCredentialProcess(ctx, profile.Values["credential_process"], creds)
+		creds.Source = fmt.Sprintf("credential_process of profile %q of %s", profile.Name, profile.Path)
+	default:
+		return creds, errors.New("no credentials found.\n\n" + credentialSources)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the credentials from %s: %w", creds.Source, err)
+	}
+
+	tflog.Info(ctx, "Using the Squadcast credentials from "+creds.Source)
+
+	return creds, nil
+}
+
+// readRefreshTokenFile reads a refresh token from a file, ignoring surrounding whitespace.
+func readRefreshTokenFile(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", errors.New("the file is empty")
+	}
+
+	return token, nil
+}
+
+// runCredentialProcess runs a credential process with the shell and reads the token it prints.
+func runCredentialProcess(ctx context.Context, command string, creds *credentials) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var output credentialProcessOutput
+	if err := json.Unmarshal(out, &output); err != nil {
+		return fmt.Errorf("the output is not valid JSON: %w", err)
+	}
+	if output.RefreshToken == "" && output.AccessToken == "" {
+		return errors.New("the output contains neither a refresh_token nor an access_token")
+	}
+
+	creds.RefreshToken = output.RefreshToken
+	creds.AccessToken = output.AccessToken
+
+	return nil
+}
+
+// credentialsProfile is a profile of the shared credentials file.
+type credentialsProfile struct {
+	Name   string
+	Path   string
+	Values map[string]string
+}
+
+// readProfile reads the profile of the provider from the shared credentials file. It returns nil if
+// the file does not exist, unless the profile or the file is set explicitly.
+func readProfile(rd *schema.ResourceData) (*credentialsProfile, error) {
+	name, explicit := rd.Get("profile").(string), true
+	if name == "" {
+		name, explicit = "default", false
+	}
+
+	path := rd.Get("shared_credentials_file").(string)
+	if path != "" {
+		explicit = true
+	} else {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, nil
+		}
+		path = filepath.Join(home, ".squadcast", "credentials")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && !explicit {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to read the shared credentials file: %w", err)
+	}
+	defer f.Close()
+
+	profiles, err := parseCredentialsFile(f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the shared credentials file %s: %w", path, err)
+	}
+
+	values, ok := profiles[name]
+	if !ok {
+		if !explicit {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("the profile %q is not in the shared credentials file %s", name, path)
+	}
+
+	return &credentialsProfile{Name: name, Path: path, Values: values}, nil
+}
+
+// parseCredentialsFile parses an INI file of profiles. Sections are either "[name]" or
+// "[profile name]", lines starting with "#" or ";" are comments.
+func parseCredentialsFile(r io.Reader) (map[string]map[string]string, error) {
+	profiles := make(map[string]map[string]string)
+
+	var profile map[string]string
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			name := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line[1:len(line)-1]), "profile "))
+			if profiles[name] == nil {
+				profiles[name] = make(map[string]string)
+			}
+			profile = profiles[name]
+		default:
+			key, value, ok := strings.Cut(line, "=")
+			if !ok {
+				return nil, fmt.Errorf("line %d: expected key = value", n)
+			}
+			if profile == nil {
+				return nil, fmt.Errorf("line %d: %s is not in a profile", n, strings.TrimSpace(key))
+			}
+			profile[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+
+	return profiles, scanner.Err()
+}
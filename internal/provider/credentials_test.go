@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestProviderCredentialSources(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("SQUADCAST_REFRESH_TOKEN", "")
+	t.Setenv("SQUADCAST_REGION", "")
+
+	tokenFile := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenFile, []byte("file-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	credentialsFile := filepath.Join(dir, "credentials")
+	if err := os.WriteFile(credentialsFile, []byte(`
+# shared credentials
+[default]
+refresh_token = default-token
+
+[profile ci]
+region = eu
+credential_process = echo '{"refresh_token": "ci-token"}'
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		config       map[string]any
+		refreshToken string
+		accessToken  string
+		region       string
+	}{
+		{
+			name:         "refresh_token wins",
+			config:       map[string]any{"refresh_token": "token", "refresh_token_file": tokenFile, "shared_credentials_file": credentialsFile},
+			refreshToken: "token",
+			region:       "us",
+		},
+		{
+			name:         "refresh_token_file",
+			config:       map[string]any{"refresh_token_file": tokenFile},
+			refreshToken: "file-token",
+			region:       "us",
+		},
+		{
+			name:        "credential_process access token",
+			config:      map[string]any{"credential_process": `echo '{"access_token": "access"}'`},
+			accessToken: "access",
+			region:      "us",
+		},
+		{
+			name:         "default profile",
+			config:       map[string]any{"shared_credentials_file": credentialsFile},
+			refreshToken: "default-token",
+			region:       "us",
+		},
+		{
+			name:         "profile with credential_process and region",
+			config:       map[string]any{"shared_credentials_file": credentialsFile, "profile": "ci"},
+			refreshToken: "ci-token",
+			region:       "eu",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config["skip_credentials_validation"] = true
+
+			p := New("dev")()
+			diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(tt.config))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			client := p.Meta().(*api.Client)
+			if client.RefreshToken != tt.refreshToken {
+				t.Errorf("expected refresh token %q, got %q", tt.refreshToken, client.RefreshToken)
+			}
+			if client.AccessToken != tt.accessToken {
+				t.Errorf("expected access token %q, got %q", tt.accessToken, client.AccessToken)
+			}
+			if client.Region != tt.region {
+				t.Errorf("expected region %q, got %q", tt.region, client.Region)
+			}
+		})
+	}
+}
+
+func TestProviderCredentialSourcesErrors(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("SQUADCAST_REFRESH_TOKEN", "")
+
+	for name, config := range map[string]map[string]any{
+		"missing token file":     {"refresh_token_file": filepath.Join(dir, "missing")},
+		"failed process":         {"credential_process": "exit 1"},
+		"invalid process output": {"credential_process": "echo token"},
+		"missing profile":        {"profile": "missing"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			config["skip_credentials_validation"] = true
+
+			diags := New("dev")().Configure(context.Background(), terraform.NewResourceConfigRaw(config))
+			if !diags.HasError() {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
@@ -36,9 +36,10 @@ func dataSourceEscalationPolicy() *schema.Resource {
 				Computed:    true,
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 			},
 			"entity_owner": {
@@ -172,10 +173,15 @@ func dataSourceEscalationPolicy() *schema.Resource {
 func dataSourceEscalationPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
+	teamID, err := dataSourceTeamID(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	tflog.Info(ctx, "Reading escalation_policy", tf.M{
 		"name": d.Get("name").(string),
 	})
-	escalationPolicy, err := client.GetEscalationPolicyByName(ctx, d.Get("team_id").(string), d.Get("name").(string))
+	escalationPolicy, err := client.GetEscalationPolicyByName(ctx, teamID, d.Get("name").(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
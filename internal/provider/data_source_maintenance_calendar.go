@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+const icsTimeLayout = "20060102T150405Z"
+
+func dataSourceMaintenanceCalendar() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the [maintenance windows](https://support.squadcast.com/docs/maintenance-mode) of one or many services as concrete occurrences within a time range, including recurring windows. The calendar is also rendered as an [iCalendar](https://datatracker.ietf.org/doc/html/rfc5545) document, which can be published with e.g. a `local_file` resource.",
+
+		ReadContext: dataSourceMaintenanceCalendarRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"service_ids": {
+				Description: "Ids of the services to include in the calendar.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: tf.ValidateObjectID,
+				},
+			},
+			"from": {
+				Description:  "Start of the calendar range as an RFC 3339 timestamp.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"till": {
+				Description:  "End of the calendar range as an RFC 3339 timestamp.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"calendar_name": {
+				Description: "Name of the calendar in the iCalendar document.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Squadcast maintenance",
+			},
+			"occurrences": {
+				Description: "Maintenance occurrences within the range, ordered by start time.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_id": {
+							Description: "Service id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"start": {
+							Description: "Start of the occurrence.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"end": {
+							Description: "End of the occurrence.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ics": {
+				Description: "The occurrences as an iCalendar document.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type serviceMaintenanceOccurrence struct {
+	ServiceID string
+	api.MaintenanceOccurrence
+}
+
+func dataSourceMaintenanceCalendarRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	from, err := time.Parse(time.RFC3339, d.Get("from").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	till, err := time.Parse(time.RFC3339, d.Get("till").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !till.After(from) {
+		return diag.Errorf("till must be after from")
+	}
+
+	serviceIDs := tf.ListToSlice[string](d.Get("service_ids"))
+
+	tflog.Info(ctx, "Reading maintenance calendar", tf.M{
+		"service_ids": serviceIDs,
+	})
+
+	occurrences := []serviceMaintenanceOccurrence{}
+	for _, serviceID := range serviceIDs {
+		windows, err := client.GetServiceMaintenanceWindows(ctx, serviceID)
+		if err != nil {
+			return diag.Errorf("unable to read the maintenance windows of service %s: %s", serviceID, err)
+		}
+
+		for _, window := range windows {
+			windowOccurrences, err := window.Occurrences(from, till)
+			if err != nil {
+				return diag.Errorf("service %s: %s", serviceID, err)
+			}
+			for _, o := range windowOccurrences {
+				occurrences = append(occurrences, serviceMaintenanceOccurrence{ServiceID: serviceID, MaintenanceOccurrence: o})
+			}
+		}
+	}
+
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		if !occurrences[i].Start.Equal(occurrences[j].Start) {
+			return occurrences[i].Start.Before(occurrences[j].Start)
+		}
+		return occurrences[i].ServiceID < occurrences[j].ServiceID
+	})
+
+	moccurrences := make([]tf.M, 0, len(occurrences))
+	for _, o := range occurrences {
+		moccurrences = append(moccurrences, tf.M{
+			"service_id": o.ServiceID,
+			"start":      o.Start.UTC().Format(time.RFC3339),
+			"end":        o.End.UTC().Format(time.RFC3339),
+		})
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(append(serviceIDs, d.Get("from").(string), d.Get("till").(string)), ","))))
+	if err := d.Set("occurrences", moccurrences); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ics", renderMaintenanceCalendar(d.Get("calendar_name").(string), occurrences)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// renderMaintenanceCalendar renders the occurrences as an iCalendar document. The output only depends on
+// the occurrences, so DTSTAMP is set to the start of each occurrence to avoid a diff on every refresh.
+func renderMaintenanceCalendar(name string, occurrences []serviceMaintenanceOccurrence) string {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Squadcast//terraform-provider-squadcast//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:" + escapeICSText(name),
+	}
+
+	for _, o := range occurrences {
+		start := o.Start.UTC().Format(icsTimeLayout)
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			fmt.Sprintf("UID:%s-%s@squadcast.com", o.ServiceID, start),
+			"DTSTAMP:"+start,
+			"DTSTART:"+start,
+			"DTEND:"+o.End.UTC().Format(icsTimeLayout),
+			"SUMMARY:"+escapeICSText(fmt.Sprintf("Maintenance of service %s", o.ServiceID)),
+			"TRANSP:TRANSPARENT",
+			"END:VEVENT",
+		)
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(foldICSLine(line))
+		b.WriteString("\r\n")
+	}
+	return b.String()
+}
+
+func escapeICSText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// foldICSLine splits lines longer than 75 octets as required by RFC 5545.
+func foldICSLine(line string) string {
+	if len(line) <= 75 {
+		return line
+	}
+
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > 75 {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceMaintenanceCalendar(t *testing.T) {
+	resourceName := "data.squadcast_maintenance_calendar.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMaintenanceCalendarDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "occurrences.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "occurrences.0.service_id", "61361611c2fc70c3101ca7dd"),
+					resource.TestCheckResourceAttr(resourceName, "occurrences.0.start", "2032-06-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "occurrences.0.end", "2032-06-01T02:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "occurrences.2.start", "2032-06-15T00:00:00Z"),
+					resource.TestMatchResourceAttr(resourceName, "ics", regexp.MustCompile(`DTSTART:20320608T000000Z\r\n`)),
+					resource.TestMatchResourceAttr(resourceName, "ics", regexp.MustCompile(`X-WR-CALNAME:Weekly maintenance\r\n`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccMaintenanceCalendarDataSourceConfig() string {
+	return fmt.Sprintf(`
+resource "squadcast_maintenance_window" "test" {
+	service_ids = ["%s"]
+	from = "2032-06-01T00:00:00Z"
+	till = "2032-06-01T02:00:00Z"
+	repeat_till = "2032-12-31T00:00:00Z"
+	repeat_frequency = "week"
+}
+
+data "squadcast_maintenance_calendar" "test" {
+	service_ids = squadcast_maintenance_window.test.target_service_ids
+	from = "2032-06-01T00:00:00Z"
+	till = "2032-06-20T00:00:00Z"
+	calendar_name = "Weekly maintenance"
+}
+	`, "61361611c2fc70c3101ca7dd")
+}
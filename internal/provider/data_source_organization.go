@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceOrganization() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get information about the organization the credentials of the provider belong to, e.g. to assert that a configuration is applied to the expected organization.",
+
+		ReadContext: dataSourceOrganizationRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Organization id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Organization name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"slug": {
+				Description: "Organization slug.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"region": {
+				Description: "The region of the provider.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceOrganizationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading organization")
+	org, err := client.GetCurrentOrganization(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(org.ID)
+	if err = tf.EncodeAndSet(org, d); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("region", client.Region)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOrganization(t *testing.T) {
+	resourceName := "data.squadcast_organization.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "slug"),
+					resource.TestCheckResourceAttrSet(resourceName, "region"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceOrganizationNotAllowed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOrganizationDataSourceConfig_notAllowed(),
+				ExpectError: regexp.MustCompile(`not in\s+allowed_organization_slugs`),
+			},
+		},
+	})
+}
+
+func testAccOrganizationDataSourceConfig() string {
+	return `
+data "squadcast_organization" "test" {}
+	`
+}
+
+func testAccOrganizationDataSourceConfig_notAllowed() string {
+	return `
+provider "squadcast" {
+	skip_credentials_validation = true
+	allowed_organization_slugs = ["not-the-organization"]
+}
+
+data "squadcast_organization" "test" {}
+	`
+}
@@ -30,9 +30,10 @@ func dataSourceRunbook() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 1000),
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 			},
 			"entity_owner": {
@@ -79,15 +80,15 @@ func dataSourceRunbookRead(ctx context.Context, d *schema.ResourceData, meta any
 		return diag.Errorf("invalid runbook name provided")
 	}
 
-	teamID, ok := d.GetOk("team_id")
-	if !ok {
-		return diag.Errorf("invalid team id provided")
+	teamID, err := dataSourceTeamID(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	tflog.Info(ctx, "Reading runbook by name", tf.M{
 		"name": name.(string),
 	})
-	runbook, err := client.GetRunbookByName(ctx, teamID.(string), name.(string))
+	runbook, err := client.GetRunbookByName(ctx, teamID, name.(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -13,8 +13,8 @@ func TestAccDataSourceRunbook(t *testing.T) {
 
 	resourceName := "data.squadcast_runbook.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRunbookDataSourceConfig(runbookName),
@@ -35,9 +35,10 @@ func dataSourceSchedule() *schema.Resource {
 				Computed:    true,
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 			},
 			"color": {
@@ -57,15 +58,15 @@ func dataSourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta an
 		return diag.Errorf("invalid schedule name provided")
 	}
 
-	teamID, ok := d.GetOk("team_id")
-	if !ok {
-		return diag.Errorf("invalid team id provided")
+	teamID, err := dataSourceTeamID(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	tflog.Info(ctx, "Reading schedule by name", tf.M{
 		"name": name.(string),
 	})
-	schedule, err := client.GetScheduleByName(ctx, teamID.(string), name.(string))
+	schedule, err := client.GetScheduleByName(ctx, teamID, name.(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
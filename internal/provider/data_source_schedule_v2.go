@@ -22,9 +22,10 @@ func dataSourceScheduleV2() *schema.Resource {
 				Computed:    true,
 			},
 			"team_id": {
-				Description: "Team id.",
+				Description: "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"name": {
 				Description: "Name of the Schedule.",
@@ -96,17 +97,17 @@ func dataSourceScheduleV2Read(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("invalid schedule name provided")
 	}
 
-	teamID, ok := d.GetOk("team_id")
-	if !ok {
-		return diag.Errorf("invalid team_id provided")
+	teamID, err := dataSourceTeamID(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	tflog.Info(ctx, "Reading schedule_v2 by name", tf.M{
 		"name":    name.(string),
-		"team_id": teamID.(string),
+		"team_id": teamID,
 	})
 
-	schedules, err := client.GetScheduleV2ByName(ctx, teamID.(string), name.(string))
+	schedules, err := client.GetScheduleV2ByName(ctx, teamID, name.(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -13,8 +13,8 @@ func TestAccDataSourceScheduleV2(t *testing.T) {
 
 	resourceName := "data.squadcast_schedule_v2.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccScheduleV2DataSourceConfig(scheduleName),
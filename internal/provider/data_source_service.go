@@ -34,9 +34,10 @@ func dataSourceService() *schema.Resource {
 				Computed:    true,
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 			},
 			"escalation_policy_id": {
@@ -53,6 +54,7 @@ func dataSourceService() *schema.Resource {
 				Description: "Unique API key of the service",
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 			},
 			"email": {
 				Description: "Email.",
@@ -110,6 +112,7 @@ func dataSourceService() *schema.Resource {
 				Description: "Active alert source endpoints.",
 				Type:        schema.TypeMap,
 				Computed:    true,
+				Sensitive:   true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -118,6 +121,7 @@ func dataSourceService() *schema.Resource {
 				Description: "All available alert source endpoints.",
 				Type:        schema.TypeMap,
 				Computed:    true,
+				Sensitive:   true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -139,15 +143,15 @@ func dataSourceServiceRead(ctx context.Context, d *schema.ResourceData, meta any
 		return diag.Errorf("invalid service name provided")
 	}
 
-	teamID, ok := d.GetOk("team_id")
-	if !ok {
-		return diag.Errorf("invalid team id provided")
+	teamID, err := dataSourceTeamID(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	tflog.Info(ctx, "Reading service by name", tf.M{
 		"name": name.(string),
 	})
-	service, err := client.GetServiceByName(ctx, teamID.(string), name.(string))
+	service, err := client.GetServiceByName(ctx, teamID, name.(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
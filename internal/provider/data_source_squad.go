@@ -29,9 +29,10 @@ func dataSourceSquad() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 1000),
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 			},
 			"member_ids": {
@@ -72,15 +73,15 @@ func dataSourceSquadRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return diag.Errorf("invalid squad name provided")
 	}
 
-	teamID, ok := d.GetOk("team_id")
-	if !ok {
-		return diag.Errorf("invalid team id provided")
+	teamID, err := dataSourceTeamID(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	tflog.Info(ctx, "Reading squad by name", tf.M{
 		"name": name.(string),
 	})
-	squad, err := client.GetSquadByName(ctx, teamID.(string), name.(string))
+	squad, err := client.GetSquadByName(ctx, teamID, name.(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
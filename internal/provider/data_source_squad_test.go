@@ -13,8 +13,8 @@ func TestAccDataSourceSquad(t *testing.T) {
 
 	resourceName := "data.squadcast_squad.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccSquadDataSourceConfig(squadName),
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceStatusPageStatuses() *schema.Resource {
+	return &schema.Resource{
+		Description: "Status pages have their own issue statuses and component impact levels. " +
+
+			"Use this data source to look up their ids by name, e.g. for the `status_page_update` block of a `squadcast_workflow_action`.",
+		ReadContext: dataSourceStatusPageStatusesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Status page id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status_page_id": {
+				Description: "Id of the status page.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"statuses": {
+				Description: "Ids of the issue statuses of the status page by name, e.g. `statuses[\"Investigating\"]`.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"impacts": {
+				Description: "Ids of the component impact levels of the status page by name, e.g. `impacts[\"Major Outage\"]`.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStatusPageStatusesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	pageID := d.Get("status_page_id").(string)
+
+	tflog.Info(ctx, "Reading status page statuses", tf.M{
+		"status_page_id": pageID,
+	})
+	statuses, err := client.ListStatusPageStatuses(ctx, pageID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	componentStatuses, err := client.ListStatusPageComponentStatuses(ctx, pageID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusIDs := make(map[string]any, len(statuses))
+	for _, status := range statuses {
+		if _, ok := statusIDs[status.Name]; ok {
+			return diag.Errorf("status page %s has more than one issue status named `%s`", pageID, status.Name)
+		}
+		statusIDs[status.Name] = int(status.ID)
+	}
+
+	impactIDs := make(map[string]any, len(componentStatuses))
+	for _, status := range componentStatuses {
+		if _, ok := impactIDs[status.Name]; ok {
+			return diag.Errorf("status page %s has more than one component impact level named `%s`", pageID, status.Name)
+		}
+		impactIDs[status.Name] = int(status.ID)
+	}
+
+	d.SetId(pageID)
+	if err = d.Set("statuses", statusIDs); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("impacts", impactIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
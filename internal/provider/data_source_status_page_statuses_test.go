@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceStatusPageStatuses(t *testing.T) {
+	statusPageName := acctest.RandomWithPrefix("statuspage")
+
+	resourceName := "data.squadcast_status_page_statuses.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatusPageStatusesDataSourceConfig(statusPageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_status_page.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "statuses.Investigating"),
+					resource.TestCheckResourceAttrSet(resourceName, "statuses.Resolved"),
+					resource.TestCheckResourceAttrSet(resourceName, "impacts.Operational"),
+					resource.TestCheckResourceAttrSet(resourceName, "impacts.Major Outage"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStatusPageStatusesDataSourceConfig(statusPageName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_status_page" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	description = "Sample status page description."
+	is_public = true
+	domain_name = "sq-statuspage"
+	timezone = "Asia/Kolkata"
+	contact_email = "test@squadcast.com"
+	owner {
+		id = "6113b0ffe4d98ae048c37010"
+		type = "user"
+	}
+	theme_color {
+		primary = "#000000"
+		secondary = "#ffffff"
+	}
+}
+
+data "squadcast_status_page_statuses" "test" {
+	status_page_id = squadcast_status_page.test.id
+}
+	`, statusPageName)
+}
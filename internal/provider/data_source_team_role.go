@@ -25,9 +25,10 @@ func dataSourceTeamRole() *schema.Resource {
 				Computed:    true,
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
@@ -58,7 +59,10 @@ func dataSourceTeamRoleRead(ctx context.Context, d *schema.ResourceData, meta an
 	client := meta.(*api.Client)
 
 	teamRoleName := d.Get("name").(string)
-	team_id := d.Get("team_id").(string)
+	team_id, err := dataSourceTeamID(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	tflog.Info(ctx, "Reading team_role", tf.M{
 		"name": teamRoleName,
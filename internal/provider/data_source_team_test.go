@@ -10,8 +10,8 @@ import (
 func TestAccDataSourceTeam(t *testing.T) {
 	resourceName := "data.squadcast_team.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTeamDataSourceConfig(),
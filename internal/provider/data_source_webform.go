@@ -27,9 +27,10 @@ func dataSourceWebform() *schema.Resource {
 				Required:    true,
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 			},
 			"custom_domain_name": {
@@ -162,16 +163,16 @@ func dataSourceWebformRead(ctx context.Context, d *schema.ResourceData, meta any
 
 	name := d.Get("name").(string)
 
-	teamID, ok := d.GetOk("team_id")
-	if !ok {
-		return diag.Errorf("invalid team id provided")
+	teamID, err := dataSourceTeamID(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	tflog.Info(ctx, "Reading webform by name", tf.M{
 		"name": name,
 	})
 
-	webform, err := client.GetWebformByName(ctx, teamID.(string), name)
+	webform, err := client.GetWebformByName(ctx, teamID, name)
 	if err != nil {
 		return diag.FromErr(err)
 	}
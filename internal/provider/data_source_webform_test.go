@@ -12,8 +12,8 @@ func TestAccDataSourceWebform(t *testing.T) {
 
 	resourceName := "data.squadcast_webform.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccWebformDataSourceConfigWithInputFields(serviceName),
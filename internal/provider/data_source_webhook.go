@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceWebhook() *schema.Resource {
+	return &schema.Resource{
+		Description: "Webhooks send incident events to an external URL. " +
+
+			"Use this data source to get information about a specific Webhook, e.g. to trigger it from a workflow action.",
+		ReadContext: dataSourceWebhookRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Webhook id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description:  "Name of the Webhook.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"team_id": {
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"description": {
+				Description: "Description of the Webhook.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "Type of the Webhook (automatic or manual).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"url": {
+				Description: "URL the Webhook is sent to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"triggers": {
+				Description: "Events which send an automatic Webhook.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_class": {
+							Description: "Class of the event.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"event_type": {
+							Description: "Type of the event.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"headers": {
+				Description: "Headers sent with the Webhook.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Description: "Header name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"value": {
+							Description: "Header value.",
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"payload_template": {
+				Description: "Custom payload of the Webhook, empty when the default payload is sent.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"entity_owner": {
+				Description: "Webhook owner.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Webhook owner id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "Webhook owner type. (user or squad or team)",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWebhookRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	name, ok := d.GetOk("name")
+	if !ok {
+		return diag.Errorf("invalid webhook name provided")
+	}
+
+	teamID, err := dataSourceTeamID(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Reading webhook by name", tf.M{
+		"name": name.(string),
+	})
+	webhook, err := client.GetWebhookByName(ctx, teamID, name.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(webhook.ID)
+	if err = tf.EncodeAndSet(webhook, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
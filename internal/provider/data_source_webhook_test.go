@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceWebhook(t *testing.T) {
+	webhookName := acctest.RandomWithPrefix("webhook")
+
+	resourceName := "data.squadcast_webhook.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookDataSourceConfig(webhookName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_webhook.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "name", webhookName),
+					resource.TestCheckResourceAttr(resourceName, "type", "manual"),
+					resource.TestCheckResourceAttr(resourceName, "url", "https://example.com/webhook"),
+					resource.TestCheckResourceAttr(resourceName, "headers.0.key", "Authorization"),
+					resource.TestCheckResourceAttr(resourceName, "headers.0.value", "Bearer token"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebhookDataSourceConfig(webhookName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_webhook" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	type = "manual"
+	url = "https://example.com/webhook"
+
+	headers {
+		key = "Authorization"
+		value = "Bearer token"
+	}
+}
+
+data "squadcast_webhook" "test" {
+	name = squadcast_webhook.test.name
+	team_id = "613611c1eb22db455cfa789f"
+}
+	`, webhookName)
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+// defaultTags returns the default tags configured in the default_tags block of the provider.
+func defaultTags(rd *schema.ResourceData) map[string]string {
+	mdefaultTags := rd.Get("default_tags").([]any)
+	if len(mdefaultTags) == 0 || mdefaultTags[0] == nil {
+		return nil
+	}
+
+	mtags := mdefaultTags[0].(map[string]any)["tags"].(map[string]any)
+	tags := make(map[string]string, len(mtags))
+	for k, v := range mtags {
+		tags[k] = v.(string)
+	}
+
+	return tags
+}
+
+// tagsAllSchema is the schema of tags_all, the effective tags of a resource.
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The tags of the resource merged with the `default_tags` of the provider.",
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// customizeDiffTagsAll plans tags_all as the tags of the resource merged with the default tags
+// of the provider, so that changing the default tags updates the resources which use them.
+func customizeDiffTagsAll(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	config := d.GetRawConfig()
+	if !config.IsKnown() || (!config.IsNull() && !config.GetAttr("tags").IsWhollyKnown()) {
+		return d.SetNewComputed("tags_all")
+	}
+
+	client := meta.(*api.Client)
+	tagsAll := mergeDefaultTags(client, tagsToMap(d.Get("tags")))
+
+	prior := make(map[string]string)
+	for k, v := range d.Get("tags_all").(map[string]any) {
+		prior[k] = v.(string)
+	}
+	if maps.Equal(tagsAll, prior) {
+		return nil
+	}
+
+	return d.SetNew("tags_all", tagsAll)
+}
+
+// mergeDefaultTags merges tags into the default tags of the provider, the tags of the resource win.
+func mergeDefaultTags(client *api.Client, tags map[string]string) map[string]string {
+	merged := maps.Clone(client.DefaultTags)
+	if merged == nil {
+		merged = make(map[string]string, len(tags))
+	}
+	maps.Copy(merged, tags)
+
+	return merged
+}
+
+// mergeDefaultTagList appends the default tags of the provider, whose keys are not in tags, to a
+// list of key/value tags. attributes are set on the appended tags, for lists which require more
+// than a key and a value.
+func mergeDefaultTagList(client *api.Client, tags []any, attributes map[string]any) []any {
+	keys := tagsToMap(tags)
+
+	merged := slices.Clone(tags)
+	for _, k := range slices.Sorted(maps.Keys(client.DefaultTags)) {
+		if _, ok := keys[k]; ok {
+			continue
+		}
+
+		tag := map[string]any{
+			"key":   k,
+			"value": client.DefaultTags[k],
+		}
+		maps.Copy(tag, attributes)
+		merged = append(merged, tag)
+	}
+
+	return merged
+}
+
+// setTagsAll sets tags_all to the tags read from the API and removes the default tags of the
+// provider from tags, unless they were in the prior tags of the resource. It must be called after
+// the resource is read into d, with the tags of the resource before the read.
+func setTagsAll(d *schema.ResourceData, client *api.Client, prior any) error {
+	priorKeys := tagsToMap(prior)
+	injected := func(k, v string) bool {
+		_, ok := priorKeys[k]
+		return !ok && hasDefaultTag(client, k, v)
+	}
+
+	switch tags := d.Get("tags").(type) {
+	case map[string]any:
+		tagsAll := tagsToMap(tags)
+		for k, v := range tagsAll {
+			if injected(k, v) {
+				delete(tags, k)
+			}
+		}
+		if err := d.Set("tags", tags); err != nil {
+			return err
+		}
+		return d.Set("tags_all", tagsAll)
+	case []any:
+		tagsAll := tagsToMap(tags)
+		filtered := make([]any, 0, len(tags))
+		for _, tag := range tags {
+			m := tag.(map[string]any)
+			if injected(m["key"].(string), m["value"].(string)) {
+				continue
+			}
+			filtered = append(filtered, tag)
+		}
+		if err := d.Set("tags", filtered); err != nil {
+			return err
+		}
+		return d.Set("tags_all", tagsAll)
+	}
+
+	return nil
+}
+
+// hasDefaultTag returns true if k is a default tag of the provider with the value v.
+func hasDefaultTag(client *api.Client, k, v string) bool {
+	dv, ok := client.DefaultTags[k]
+	return ok && dv == v
+}
+
+// tagsToMap converts tags, either a map or a list of key/value tags, to a map.
+func tagsToMap(tags any) map[string]string {
+	m := make(map[string]string)
+	switch tags := tags.(type) {
+	case map[string]any:
+		for k, v := range tags {
+			m[k] = v.(string)
+		}
+	case []any:
+		for _, tag := range tags {
+			if tag, ok := tag.(map[string]any); ok {
+				m[tag["key"].(string)] = tag["value"].(string)
+			}
+		}
+	}
+
+	return m
+}
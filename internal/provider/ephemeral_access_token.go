@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &accessTokenEphemeralResource{}
+
+type accessTokenEphemeralResource struct {
+	client *api.Client
+}
+
+type accessTokenModel struct {
+	AccessToken types.String `tfsdk:"access_token"`
+	Type        types.String `tfsdk:"type"`
+	IssuedAt    types.String `tfsdk:"issued_at"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+}
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &accessTokenEphemeralResource{}
+}
+
+func (r *accessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *accessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exchanges the refresh token of the provider for a short-lived Squadcast access token. The token is never stored in the plan or state, which makes it suitable for configuring other providers or tools calling the Squadcast API.",
+
+		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "The access token, to be sent as a bearer token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The token type.",
+				Computed:            true,
+			},
+			"issued_at": schema.StringAttribute{
+				MarkdownDescription: "Time at which the token was issued, as an RFC 3339 timestamp.",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Time at which the token expires, as an RFC 3339 timestamp.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *accessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*api.Client)
+}
+
+func (r *accessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	tflog.Info(ctx, "Requesting a new access token")
+
+	// Authenticating first checks the organization of the credentials.
+	if err := r.client.Authenticate(ctx); err != nil {
+		resp.Diagnostics.AddError("Unable to authenticate", err.Error())
+		return
+	}
+
+	token, err := r.client.GetAccessToken(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get an access token", err.Error())
+		return
+	}
+
+	m := accessTokenModel{
+		AccessToken: types.StringValue(token.AccessToken),
+		Type:        types.StringValue(token.Type),
+		IssuedAt:    types.StringValue(time.Unix(token.IssuedAt, 0).UTC().Format(time.RFC3339)),
+		ExpiresAt:   types.StringValue(time.Unix(token.ExpiresAt, 0).UTC().Format(time.RFC3339)),
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &m)...)
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEphemeralAccessToken(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralAccessTokenConfig(),
+			},
+		},
+	})
+}
+
+func testAccEphemeralAccessTokenConfig() string {
+	return `
+ephemeral "squadcast_access_token" "test" {
+}
+
+locals {
+	authorization = "Bearer ${ephemeral.squadcast_access_token.test.access_token}"
+}
+`
+}
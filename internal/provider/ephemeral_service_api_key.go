@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &serviceAPIKeyEphemeralResource{}
+
+type serviceAPIKeyEphemeralResource struct {
+	client *api.Client
+}
+
+type serviceAPIKeyModel struct {
+	ServiceID                  types.String      `tfsdk:"service_id"`
+	TeamID                     types.String      `tfsdk:"team_id"`
+	APIKey                     types.String      `tfsdk:"api_key"`
+	ActiveAlertSourceEndpoints map[string]string `tfsdk:"active_alert_source_endpoints"`
+	AlertSourceEndpoints       map[string]string `tfsdk:"alert_source_endpoints"`
+}
+
+func NewServiceAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &serviceAPIKeyEphemeralResource{}
+}
+
+func (r *serviceAPIKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_api_key"
+}
+
+func (r *serviceAPIKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the API key of a service and the alert source endpoints which embed it, without storing them in the plan or state. Use it to configure webhooks of monitoring tools managed by other providers.",
+
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Service id.",
+				Required:            true,
+				Validators:          []validator.String{tf.ObjectIDValidator()},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team id. Defaults to the `default_team_id` of the provider.",
+				Optional:            true,
+				Validators:          []validator.String{tf.ObjectIDValidator()},
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "Unique API key of the service.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"active_alert_source_endpoints": schema.MapAttribute{
+				MarkdownDescription: "Endpoints of the alert sources active on the service, keyed by their short name.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"alert_source_endpoints": schema.MapAttribute{
+				MarkdownDescription: "Endpoints of all available alert sources, keyed by their short name.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *serviceAPIKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*api.Client)
+}
+
+func (r *serviceAPIKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var m serviceAPIKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &m)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, err := frameworkTeamID(m.TeamID, r.client)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("team_id"), "Missing team id", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Reading service api key", tf.M{
+		"id":      m.ServiceID.ValueString(),
+		"team_id": teamID,
+	})
+
+	service, err := r.client.GetServiceById(ctx, teamID, m.ServiceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read the service", err.Error())
+		return
+	}
+
+	activeAlertSources, err := r.client.ListActiveAlertSources(ctx, service.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read the active alert sources of the service", err.Error())
+		return
+	}
+
+	alertSources, err := r.client.ListAlertSources(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read the alert sources", err.Error())
+		return
+	}
+
+	m.APIKey = types.StringValue(service.APIKey)
+	m.ActiveAlertSourceEndpoints = make(map[string]string, len(activeAlertSources.AlertSources))
+	for _, activeAlertSource := range activeAlertSources.AlertSources {
+		for _, alertSource := range alertSources {
+			if activeAlertSource.ID == alertSource.ID {
+				m.ActiveAlertSourceEndpoints[alertSource.ShortName] = alertSource.Endpoint(r.client.IngestionBaseURL, service)
+			}
+		}
+	}
+	m.AlertSourceEndpoints = alertSources.Available().EndpointMap(r.client.IngestionBaseURL, service)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &m)...)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEphemeralServiceAPIKey(t *testing.T) {
+	serviceName := acctest.RandomWithPrefix("service")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralServiceAPIKeyConfig(serviceName),
+			},
+		},
+	})
+}
+
+func testAccEphemeralServiceAPIKeyConfig(serviceName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_service" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "61361415c2fc70c3101ca7db"
+	email_prefix = "%s"
+	alert_sources = ["Datadog"]
+}
+
+ephemeral "squadcast_service_api_key" "test" {
+	service_id = squadcast_service.test.id
+	team_id = squadcast_service.test.team_id
+}
+
+locals {
+	datadog_webhook_url = ephemeral.squadcast_service_api_key.test.active_alert_source_endpoints["datadog"]
+}
+`, serviceName, serviceName)
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// frameworkProvider serves the resources built on terraform-plugin-framework. It is muxed with the
+// SDKv2 provider, which is configured first, and reuses the client configured by it.
+type frameworkProvider struct {
+	version string
+	sdk     *sdkschema.Provider
+}
+
+var (
+	_ fwprovider.Provider                       = &frameworkProvider{}
+	_ fwprovider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ fwprovider.ProviderWithFunctions          = &frameworkProvider{}
+	_ fwprovider.ProviderWithListResources      = &frameworkProvider{}
+)
+
+// NewFramework returns the terraform-plugin-framework half of the provider.
+func NewFramework(version string, sdk *sdkschema.Provider) func() fwprovider.Provider {
+	return func() fwprovider.Provider {
+		return &frameworkProvider{
+			version: version,
+			sdk:     sdk,
+		}
+	}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "squadcast"
+	resp.Version = p.version
+}
+
+// Schema must be identical to the schema of the SDKv2 provider, the mux server rejects differing schemas.
+func (p *frameworkProvider) Schema(ctx context.Context, req fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region you are currently hosted on." +
+					"Supported values are \"us\" and \"eu\"",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("us", "eu", "internal", "staging", "dev"),
+				},
+			},
+			"refresh_token": schema.StringAttribute{
+				MarkdownDescription: "The refresh token, This can be created from user profile",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"refresh_token_file": schema.StringAttribute{
+				MarkdownDescription: "A file containing the refresh token. It can also be set with the `SQUADCAST_REFRESH_TOKEN_FILE` environment variable.",
+				Optional:            true,
+			},
+			"credential_process": schema.StringAttribute{
+				MarkdownDescription: "A command which prints the credentials as JSON, with either a `refresh_token` or an `access_token`. It is run with the shell, e.g. to read the token from a password manager. It can also be set with the `SQUADCAST_CREDENTIAL_PROCESS` environment variable.",
+				Optional:            true,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "The profile of the shared credentials file, which holds a `region` and either a `refresh_token` or a `credential_process`. Defaults to `default`. It can also be set with the `SQUADCAST_PROFILE` environment variable.",
+				Optional:            true,
+			},
+			"shared_credentials_file": schema.StringAttribute{
+				MarkdownDescription: "The path of the shared credentials file. Defaults to `~/.squadcast/credentials`. It can also be set with the `SQUADCAST_SHARED_CREDENTIALS_FILE` environment variable.",
+				Optional:            true,
+			},
+			"default_team_id": schema.StringAttribute{
+				MarkdownDescription: "The team id used by resources and data sources which do not set `team_id`. It can also be set with the `SQUADCAST_TEAM_ID` environment variable.",
+				Optional:            true,
+				Validators:          []validator.String{tf.ObjectIDValidator()},
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				MarkdownDescription: "Skip the authentication when the provider is configured, it authenticates on its first request instead. A missing or invalid `refresh_token` then only fails once a request is made. It can also be set with the `SQUADCAST_SKIP_CREDENTIALS_VALIDATION` environment variable.",
+				Optional:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Refuse every request which would change Squadcast, while data sources and refreshes still work. It can also be set with the `SQUADCAST_READ_ONLY` environment variable.",
+				Optional:            true,
+			},
+			"allowed_organization_ids": schema.SetAttribute{
+				MarkdownDescription: "The ids of the organizations the provider is allowed to manage. The provider fails when its credentials belong to another organization.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"allowed_organization_slugs": schema.SetAttribute{
+				MarkdownDescription: "The slugs of the organizations the provider is allowed to manage. The provider fails when its credentials belong to another organization.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.ListNestedBlock{
+				MarkdownDescription: "Tags merged into the tags of every taggable resource. Tags set on a resource take precedence over the default tags.",
+				Validators:          []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tags": schema.MapAttribute{
+							MarkdownDescription: "The default tags.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	client, ok := p.sdk.Meta().(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Provider not configured", "The Squadcast client has not been configured, this is a bug in the provider.")
+		return
+	}
+
+	resp.ResourceData = client
+	resp.DataSourceData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewScheduleRotationV2Resource,
+		NewWorkflowActionResource,
+	}
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
+		NewServiceAPIKeyEphemeralResource,
+	}
+}
+
+func (p *frameworkProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewEscalationPolicyListResource,
+		NewRunbookListResource,
+		NewScheduleV2ListResource,
+		NewServiceListResource,
+		NewSquadListResource,
+		NewWebformListResource,
+	}
+}
+
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewAlertSourceEndpointFunction,
+		NewParseImportIDFunction,
+		NewRotationShiftsFunction,
+		NewServiceEmailFunction,
+	}
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+var _ function.Function = &alertSourceEndpointFunction{}
+
+type alertSourceEndpointFunction struct{}
+
+func NewAlertSourceEndpointFunction() function.Function {
+	return &alertSourceEndpointFunction{}
+}
+
+func (f *alertSourceEndpointFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "alert_source_endpoint"
+}
+
+func (f *alertSourceEndpointFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Returns the ingestion URL of an alert source for a service.",
+		MarkdownDescription: "Returns the URL to which an alert source sends the alerts of a service, as listed in `alert_source_endpoints` of `squadcast_service`. The URL is built without any API call. Alert sources which only display the service api key use the api key itself, and the `email` alert source uses the service email, see `service_email`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "version",
+				MarkdownDescription: "Version of the alert source integration, e.g. `v1` or `v2`.",
+			},
+			function.StringParameter{
+				Name:                "shortname",
+				MarkdownDescription: "Short name of the alert source, e.g. `prometheus`.",
+			},
+			function.StringParameter{
+				Name:                "api_key",
+				MarkdownDescription: "Api key of the service.",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "region",
+			MarkdownDescription: "Region of the organization, defaults to `us`.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *alertSourceEndpointFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var version, shortName, apiKey string
+	var regions []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &version, &shortName, &apiKey, &regions))
+	if resp.Error != nil {
+		return
+	}
+
+	if shortName == "email" {
+		resp.Error = function.NewArgumentFuncError(1, "The email alert source has no URL, use provider::squadcast::service_email instead.")
+		return
+	}
+
+	client, err := regionClient(3, regions)
+	if err != nil {
+		resp.Error = err
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, api.AlertSourceURL(client.IngestionBaseURL, version, shortName, apiKey))
+}
+
+// regionClient returns an unauthenticated client for the region given as the optional variadic
+// argument at position argument.
+func regionClient(argument int64, regions []string) (*api.Client, *function.FuncError) {
+	region := "us"
+	switch len(regions) {
+	case 0:
+	case 1:
+		region = regions[0]
+	default:
+		return nil, function.NewArgumentFuncError(argument+1, "At most one region can be given.")
+	}
+
+	return newRegionClient(argument, region)
+}
+
+func newRegionClient(argument int64, region string) (*api.Client, *function.FuncError) {
+	client := &api.Client{}
+	if err := client.SetRegion(region); err != nil {
+		regions := make([]string, 0, len(api.RegionHosts))
+		for r := range api.RegionHosts {
+			regions = append(regions, r)
+		}
+		sort.Strings(regions)
+		return nil, function.NewArgumentFuncError(argument, "Unsupported region "+region+", expected one of "+strings.Join(regions, ", ")+".")
+	}
+	return client, nil
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFunctionAlertSourceEndpoint(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig + `
+output "us" {
+	value = provider::squadcast::alert_source_endpoint("v2", "prometheus", "0123456789abcdef")
+}
+
+output "eu" {
+	value = provider::squadcast::alert_source_endpoint("v1", "grafana", "0123456789abcdef", "eu")
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("us", "https://api.squadcast.com/v2/incidents/prometheus/0123456789abcdef"),
+					resource.TestCheckOutput("eu", "https://api.eu.squadcast.com/v1/incidents/grafana/0123456789abcdef"),
+				),
+			},
+			{
+				Config: testAccFunctionConfig + `
+output "test" {
+	value = provider::squadcast::alert_source_endpoint("v1", "email", "0123456789abcdef")
+}
+				`,
+				ExpectError: regexp.MustCompile(`use provider::squadcast::service_email`),
+			},
+		},
+	})
+}
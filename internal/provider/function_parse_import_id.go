@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseImportIDFunction{}
+
+type parseImportIDFunction struct{}
+
+func NewParseImportIDFunction() function.Function {
+	return &parseImportIDFunction{}
+}
+
+func (f *parseImportIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_import_id"
+}
+
+func (f *parseImportIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Splits an import id into its parts.",
+		MarkdownDescription: "Splits an import id like `teamID:ID` into its parts, the same way the resources of this provider do on import. The last part keeps any further `:`, so names containing `:` are preserved.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "The import id.",
+			},
+			function.Int64Parameter{
+				Name:                "parts",
+				MarkdownDescription: "The number of parts the import id consists of.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *parseImportIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	var parts int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id, &parts))
+	if resp.Error != nil {
+		return
+	}
+
+	if parts < 1 {
+		resp.Error = function.NewArgumentFuncError(1, "The number of parts must be at least 1.")
+		return
+	}
+
+	values, err := parseImportID(id, int(parts))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, values)
+}
+
+// parseImportID splits id into n non-empty parts separated by ":".
+func parseImportID(id string, n int) ([]string, error) {
+	parts := strings.SplitN(id, ":", n)
+
+	if len(parts) != n {
+		return nil, fmt.Errorf("unexpected format of import resource id (%s), expected %d parts separated by ':'", id, n)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("unexpected format of import resource id (%s), expected %d non-empty parts separated by ':'", id, n)
+		}
+	}
+
+	return parts, nil
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFunctionParseImportID(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig + `
+locals {
+	parts = provider::squadcast::parse_import_id("613611c1eb22db455cfa789f:Primary:Rotation: nights", 3)
+}
+
+output "team_id" {
+	value = local.parts[0]
+}
+
+output "rotation" {
+	value = local.parts[2]
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckOutput("rotation", "Rotation: nights"),
+				),
+			},
+			{
+				Config: testAccFunctionConfig + `
+output "test" {
+	value = provider::squadcast::parse_import_id("613611c1eb22db455cfa789f", 2)
+}
+				`,
+				ExpectError: regexp.MustCompile(`expected 2 parts separated by ':'`),
+			},
+		},
+	})
+}
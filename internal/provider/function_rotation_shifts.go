@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+var _ function.Function = &rotationShiftsFunction{}
+
+type rotationShiftsFunction struct{}
+
+// rotationPatternModel holds the attributes of squadcast_schedule_rotation_v2 which define its shifts.
+type rotationPatternModel struct {
+	StartDate                   types.String            `tfsdk:"start_date"`
+	Period                      types.String            `tfsdk:"period"`
+	ShiftTimeslots              []shiftTimeslotModel    `tfsdk:"shift_timeslots"`
+	CustomPeriodFrequency       types.Int64             `tfsdk:"custom_period_frequency"`
+	CustomPeriodUnit            types.String            `tfsdk:"custom_period_unit"`
+	ChangeParticipantsFrequency types.Int64             `tfsdk:"change_participants_frequency"`
+	ChangeParticipantsUnit      types.String            `tfsdk:"change_participants_unit"`
+	ParticipantGroups           []participantGroupModel `tfsdk:"participant_groups"`
+	EndDate                     types.String            `tfsdk:"end_date"`
+	EndsAfterIterations         types.Int64             `tfsdk:"ends_after_iterations"`
+}
+
+type rotationShiftModel struct {
+	Start            string             `tfsdk:"start"`
+	End              string             `tfsdk:"end"`
+	ParticipantGroup types.Int64        `tfsdk:"participant_group"`
+	Participants     []participantModel `tfsdk:"participants"`
+}
+
+var participantType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type": types.StringType,
+		"id":   types.StringType,
+	},
+}
+
+func NewRotationShiftsFunction() function.Function {
+	return &rotationShiftsFunction{}
+}
+
+func (f *rotationShiftsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "rotation_shifts"
+}
+
+func (f *rotationShiftsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Expands a schedule rotation into its on-call shifts.",
+		MarkdownDescription: "Expands the rotation pattern of a `squadcast_schedule_rotation_v2` into the on-call shifts within a time range, without any API call. The rotation can be given as the resource itself, or as an object with the pattern attributes of the resource, where unset attributes are `null`. Each shift has its `start` and `end`, the index of the `participant_group` on call and its `participants`. Overrides made in Squadcast are not taken into account.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:                "rotation",
+				MarkdownDescription: "The rotation, with the attributes `start_date`, `period`, `shift_timeslots`, `custom_period_frequency`, `custom_period_unit`, `change_participants_frequency`, `change_participants_unit`, `participant_groups`, `end_date` and `ends_after_iterations`.",
+				AttributeTypes: map[string]attr.Type{
+					"start_date": types.StringType,
+					"period":     types.StringType,
+					"shift_timeslots": types.ListType{ElemType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"start_hour":   types.Int64Type,
+							"start_minute": types.Int64Type,
+							"duration":     types.Int64Type,
+							"day_of_week":  types.StringType,
+						},
+					}},
+					"custom_period_frequency":       types.Int64Type,
+					"custom_period_unit":            types.StringType,
+					"change_participants_frequency": types.Int64Type,
+					"change_participants_unit":      types.StringType,
+					"participant_groups": types.ListType{ElemType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"participants": types.ListType{ElemType: participantType},
+						},
+					}},
+					"end_date":              types.StringType,
+					"ends_after_iterations": types.Int64Type,
+				},
+			},
+			function.StringParameter{
+				Name:                "time_zone",
+				MarkdownDescription: "IANA time zone of the schedule, e.g. `Asia/Kolkata`.",
+			},
+			function.StringParameter{
+				Name:                "from",
+				MarkdownDescription: "Start of the range as an RFC 3339 timestamp.",
+			},
+			function.StringParameter{
+				Name:                "till",
+				MarkdownDescription: "End of the range as an RFC 3339 timestamp.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"start":             types.StringType,
+					"end":               types.StringType,
+					"participant_group": types.Int64Type,
+					"participants":      types.ListType{ElemType: participantType},
+				},
+			},
+		},
+	}
+}
+
+func (f *rotationShiftsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern rotationPatternModel
+	var timeZone, fromArg, tillArg string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &timeZone, &fromArg, &tillArg))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := tf.CheckTimezone(timeZone); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "Invalid time zone: "+err.Error())
+		return
+	}
+	loc, err := time.LoadLocation(tf.CanonicalTimezone(timeZone))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "Invalid time zone "+timeZone+": "+err.Error())
+		return
+	}
+	from, err := time.Parse(time.RFC3339, fromArg)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, "Invalid from, expected an RFC 3339 timestamp: "+err.Error())
+		return
+	}
+	till, err := time.Parse(time.RFC3339, tillArg)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(3, "Invalid till, expected an RFC 3339 timestamp: "+err.Error())
+		return
+	}
+
+	rotation := (&scheduleRotationV2Model{
+		StartDate:                   pattern.StartDate,
+		Period:                      pattern.Period,
+		ShiftTimeslots:              pattern.ShiftTimeslots,
+		CustomPeriodFrequency:       pattern.CustomPeriodFrequency,
+		CustomPeriodUnit:            pattern.CustomPeriodUnit,
+		ChangeParticipantsFrequency: pattern.ChangeParticipantsFrequency,
+		ChangeParticipantsUnit:      pattern.ChangeParticipantsUnit,
+		ParticipantGroups:           pattern.ParticipantGroups,
+		EndDate:                     pattern.EndDate,
+		EndsAfterIterations:         pattern.EndsAfterIterations,
+	}).expand()
+
+	shifts, err := rotation.Shifts(loc, from, till)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result := make([]rotationShiftModel, 0, len(shifts))
+	for _, shift := range shifts {
+		m := rotationShiftModel{
+			Start:            shift.Start.Format(time.RFC3339),
+			End:              shift.End.Format(time.RFC3339),
+			ParticipantGroup: types.Int64Null(),
+			Participants:     []participantModel{},
+		}
+		if shift.ParticipantGroup >= 0 {
+			m.ParticipantGroup = types.Int64Value(int64(shift.ParticipantGroup))
+			m.Participants = pattern.ParticipantGroups[shift.ParticipantGroup].Participants
+		}
+		result = append(result, m)
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
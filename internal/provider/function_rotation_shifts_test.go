@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFunctionRotationShifts(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig + `
+locals {
+	shifts = provider::squadcast::rotation_shifts({
+		start_date = "2024-06-03T00:00:00Z"
+		period = "custom"
+		custom_period_frequency = 1
+		custom_period_unit = "week"
+		shift_timeslots = [
+			{ start_hour = 22, start_minute = 0, duration = 600, day_of_week = "saturday" },
+			{ start_hour = 10, start_minute = 30, duration = 720, day_of_week = "sunday" },
+		]
+		change_participants_frequency = 1
+		change_participants_unit = "rotation"
+		participant_groups = [
+			{ participants = [{ type = "user", id = "613611c1eb22db455cfa789f" }] },
+			{ participants = [{ type = "team", id = "61361415c2fc70c3101ca7db" }] },
+		]
+		end_date = null
+		ends_after_iterations = null
+	}, "Asia/Kolkata", "2024-06-08T00:00:00+05:30", "2024-06-17T00:00:00+05:30")
+}
+
+output "count" {
+	value = length(local.shifts)
+}
+
+output "first_start" {
+	value = local.shifts[0].start
+}
+
+output "first_end" {
+	value = local.shifts[0].end
+}
+
+output "last_group" {
+	value = local.shifts[2].participant_group
+}
+
+output "last_participant" {
+	value = local.shifts[2].participants[0].id
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("count", "4"),
+					resource.TestCheckOutput("first_start", "2024-06-08T22:00:00+05:30"),
+					resource.TestCheckOutput("first_end", "2024-06-09T08:00:00+05:30"),
+					resource.TestCheckOutput("last_group", "1"),
+					resource.TestCheckOutput("last_participant", "61361415c2fc70c3101ca7db"),
+				),
+			},
+		},
+	})
+}
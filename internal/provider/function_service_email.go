@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &serviceEmailFunction{}
+
+type serviceEmailFunction struct{}
+
+func NewServiceEmailFunction() function.Function {
+	return &serviceEmailFunction{}
+}
+
+func (f *serviceEmailFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "service_email"
+}
+
+func (f *serviceEmailFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Returns the email address of a service.",
+		MarkdownDescription: "Returns the email address to which alerts of a service can be sent, as set in `email` of `squadcast_service`. The address is built from the `email_prefix` of the service and the slug of the organization without any API call. Unlike the region, the slug of the organization is part of the address and cannot be derived offline, so it must be given, e.g. from `slug` of the `squadcast_organization` data source.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "prefix",
+				MarkdownDescription: "Email prefix of the service.",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region of the organization, e.g. `us` or `eu`.",
+			},
+			function.StringParameter{
+				Name:                "organization_slug",
+				MarkdownDescription: "Slug of the organization.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *serviceEmailFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var prefix, region, organizationSlug string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &prefix, &region, &organizationSlug))
+	if resp.Error != nil {
+		return
+	}
+
+	if prefix == "" {
+		resp.Error = function.NewArgumentFuncError(0, "The email prefix cannot be empty.")
+		return
+	}
+	if organizationSlug == "" {
+		resp.Error = function.NewArgumentFuncError(2, "The slug of the organization cannot be empty.")
+		return
+	}
+
+	client, err := newRegionClient(1, region)
+	if err != nil {
+		resp.Error = err
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, client.ServiceEmail(prefix, organizationSlug))
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFunctionServiceEmail(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig + `
+output "us" {
+	value = provider::squadcast::service_email("payments", "us", "acme")
+}
+
+output "eu" {
+	value = provider::squadcast::service_email("payments", "eu", "acme")
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("us", "payments@acme.incidents.squadcast.com"),
+					resource.TestCheckOutput("eu", "payments@acme.incidents.eu.squadcast.com"),
+				),
+			},
+			{
+				Config: testAccFunctionConfig + `
+output "test" {
+	value = provider::squadcast::service_email("payments", "mars", "acme")
+}
+				`,
+				ExpectError: regexp.MustCompile(`Unsupported region mars`),
+			},
+			{
+				Config: testAccFunctionConfig + `
+output "test" {
+	value = provider::squadcast::service_email("payments", "us", "")
+}
+				`,
+				ExpectError: regexp.MustCompile(`slug of the organization\s+cannot be empty`),
+			},
+		},
+	})
+}
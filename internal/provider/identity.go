@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// teamResourceIdentity is the identity of resources which are read by their team id and id.
+func teamResourceIdentity() *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"team_id": {
+					Description:       "Team id.",
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+				},
+				"id": {
+					Description:       "Resource id.",
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+				},
+			}
+		},
+	}
+}
+
+// idResourceIdentity is the identity of resources which are read by their id alone.
+func idResourceIdentity() *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"id": {
+					Description:       "Resource id.",
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+				},
+			}
+		},
+	}
+}
+
+// setIdentity sets the identity of d from its id and the given attributes.
+func setIdentity(d *schema.ResourceData, attributes ...string) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	if err := identity.Set("id", d.Id()); err != nil {
+		return err
+	}
+	for _, attribute := range attributes {
+		if err := identity.Set(attribute, d.Get(attribute)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importIdentity imports a resource from its identity, when it is imported with an identity
+// instead of an import id.
+func importIdentity(d *schema.ResourceData, attributes ...string) ([]*schema.ResourceData, error) {
+	identity, err := d.Identity()
+	if err != nil {
+		return nil, err
+	}
+
+	id, ok := identity.GetOk("id")
+	if !ok {
+		return nil, fmt.Errorf("expected the identity to contain id")
+	}
+	for _, attribute := range attributes {
+		v, ok := identity.GetOk(attribute)
+		if !ok {
+			return nil, fmt.Errorf("expected the identity to contain %s", attribute)
+		}
+		d.Set(attribute, v)
+	}
+	d.SetId(id.(string))
+
+	return []*schema.ResourceData{d}, nil
+}
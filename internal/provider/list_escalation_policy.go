@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func NewEscalationPolicyListResource() list.ListResource {
+	return &sdkListResource{
+		typeName:           "escalation_policy",
+		description:        "escalation policies",
+		resource:           resourceEscalationPolicy,
+		identityAttributes: []string{"team_id"},
+		list: func(ctx context.Context, client *api.Client, teamID string) ([]listItem, error) {
+			escalationPolicies, err := client.ListEscalationPolicies(ctx, teamID)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]listItem, 0, len(escalationPolicies))
+			for _, escalationPolicy := range escalationPolicies {
+				items = append(items, listItem{ID: escalationPolicy.ID, Name: escalationPolicy.Name})
+			}
+			return items, nil
+		},
+	}
+}
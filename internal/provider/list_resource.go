@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+var (
+	_ list.ListResourceWithConfigure    = &sdkListResource{}
+	_ list.ListResourceWithRawV5Schemas = &sdkListResource{}
+)
+
+// sdkListResource lists the instances of a team's resources, which are implemented with the SDKv2.
+// Each result is read with the Read function of the resource, so it has the same identity and state
+// as an imported instance.
+type sdkListResource struct {
+	client *api.Client
+
+	// typeName is the name of the listed resource, without the provider prefix.
+	typeName string
+	// description describes the listed resources, e.g. "services".
+	description string
+	// resource returns the SDKv2 resource.
+	resource func() *sdkschema.Resource
+	// identityAttributes are the attributes in the identity of the resource besides id.
+	identityAttributes []string
+	// list lists the resources of a team.
+	list func(ctx context.Context, client *api.Client, teamID string) ([]listItem, error)
+	// listByName lists the resources of a team with a name, for resources which the API only looks
+	// up by name. It is used when list is not set, the name is then required.
+	listByName func(ctx context.Context, client *api.Client, teamID, name string) ([]listItem, error)
+}
+
+// listItem is a resource returned by a list call.
+type listItem struct {
+	ID   string
+	Name string
+}
+
+type listResourceModel struct {
+	TeamID types.String `tfsdk:"team_id"`
+	Name   types.String `tfsdk:"name"`
+}
+
+func (r *sdkListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName
+}
+
+func (r *sdkListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	nameAttribute := schema.StringAttribute{
+		MarkdownDescription: "Only list the " + r.description + " whose name contains this value, ignoring case.",
+		Optional:            true,
+	}
+	if r.list == nil {
+		nameAttribute = schema.StringAttribute{
+			MarkdownDescription: "Name of the " + r.description + " to list. The API only looks up " + r.description + " by name, so it is required.",
+			Required:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the " + r.description + " of a team.",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team id. Defaults to the `default_team_id` of the provider.",
+				Optional:            true,
+				Validators:          []validator.String{tf.ObjectIDValidator()},
+			},
+			"name": nameAttribute,
+		},
+	}
+}
+
+func (r *sdkListResource) RawV5Schemas(ctx context.Context, req list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	res := r.resource()
+	resp.ProtoV5Schema = res.ProtoSchema(ctx)()
+	resp.ProtoV5IdentitySchema = res.ProtoIdentitySchema(ctx)()
+}
+
+func (r *sdkListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*api.Client)
+}
+
+func (r *sdkListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config listResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	teamID, err := frameworkTeamID(config.TeamID, r.client)
+	if err != nil {
+		diags.AddAttributeError(path.Root("team_id"), "Missing team id", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Info(ctx, "Listing "+r.description, tf.M{
+		"team_id": teamID,
+		"name":    config.Name.ValueString(),
+	})
+
+	var items []listItem
+	if r.list != nil {
+		items, err = r.list(ctx, r.client, teamID)
+	} else {
+		items, err = r.listByName(ctx, r.client, teamID, config.Name.ValueString())
+	}
+	if err != nil {
+		diags.AddError("Unable to list the "+r.description, err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	name := strings.ToLower(config.Name.ValueString())
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, item := range items {
+			if name != "" && !strings.Contains(strings.ToLower(item.Name), name) {
+				continue
+			}
+
+			result, ok := r.result(ctx, req, teamID, item)
+			if !ok {
+				continue
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// result reads a listed resource into a list result. It returns false if the resource no longer exists.
+func (r *sdkListResource) result(ctx context.Context, req list.ListRequest, teamID string, item listItem) (list.ListResult, bool) {
+	result := req.NewListResult(ctx)
+	result.DisplayName = item.Name
+
+	res := r.resource()
+	d := res.Data(nil)
+	d.SetId(item.ID)
+	d.Set("team_id", teamID)
+
+	if req.IncludeResource {
+		appendSDKDiagnostics(&result.Diagnostics, res.ReadContext(ctx, d, r.client))
+		if result.Diagnostics.HasError() {
+			return result, true
+		}
+		if d.Id() == "" {
+			return result, false
+		}
+	} else if err := setIdentity(d, r.identityAttributes...); err != nil {
+		result.Diagnostics.AddError("Unable to set the identity of "+item.Name, err.Error())
+		return result, true
+	}
+
+	identity, err := d.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError("Unable to set the identity of "+item.Name, err.Error())
+		return result, true
+	}
+	result.Identity.Raw = identity.Copy()
+
+	if req.IncludeResource {
+		state, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("Unable to set the state of "+item.Name, err.Error())
+			return result, true
+		}
+		result.Resource.Raw = state.Copy()
+	}
+
+	return result, true
+}
+
+// appendSDKDiagnostics appends the diagnostics returned by an SDKv2 function to diags.
+func appendSDKDiagnostics(diags *diag.Diagnostics, sdkDiags sdkdiag.Diagnostics) {
+	for _, d := range sdkDiags {
+		if d.Severity == sdkdiag.Error {
+			diags.AddError(d.Summary, d.Detail)
+		} else {
+			diags.AddWarning(d.Summary, d.Detail)
+		}
+	}
+}
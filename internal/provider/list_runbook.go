@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func NewRunbookListResource() list.ListResource {
+	return &sdkListResource{
+		typeName:           "runbook",
+		description:        "runbooks",
+		resource:           resourceRunbook,
+		identityAttributes: []string{"team_id"},
+		list: func(ctx context.Context, client *api.Client, teamID string) ([]listItem, error) {
+			runbooks, err := client.ListRunbooks(ctx, teamID)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]listItem, 0, len(runbooks))
+			for _, runbook := range runbooks {
+				items = append(items, listItem{ID: runbook.ID, Name: runbook.Name})
+			}
+			return items, nil
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func NewScheduleV2ListResource() list.ListResource {
+	return &sdkListResource{
+		typeName:    "schedule_v2",
+		description: "schedules",
+		resource:    resourceScheduleV2,
+		listByName: func(ctx context.Context, client *api.Client, teamID, name string) ([]listItem, error) {
+			schedules, err := client.GetScheduleV2ByName(ctx, teamID, name)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]listItem, 0, len(schedules.NewSchedule))
+			for _, schedule := range schedules.NewSchedule {
+				items = append(items, listItem{ID: strconv.Itoa(schedule.ID), Name: schedule.Name})
+			}
+			return items, nil
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func NewServiceListResource() list.ListResource {
+	return &sdkListResource{
+		typeName:           "service",
+		description:        "services",
+		resource:           resourceService,
+		identityAttributes: []string{"team_id"},
+		list: func(ctx context.Context, client *api.Client, teamID string) ([]listItem, error) {
+			services, err := client.ListServices(ctx, teamID)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]listItem, 0, len(services))
+			for _, service := range services {
+				items = append(items, listItem{ID: service.ID, Name: service.Name})
+			}
+			return items, nil
+		},
+	}
+}
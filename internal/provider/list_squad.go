@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func NewSquadListResource() list.ListResource {
+	return &sdkListResource{
+		typeName:           "squad",
+		description:        "squads",
+		resource:           resourceSquad,
+		identityAttributes: []string{"team_id"},
+		list: func(ctx context.Context, client *api.Client, teamID string) ([]listItem, error) {
+			squads, err := client.ListSquads(ctx, teamID)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]listItem, 0, len(squads))
+			for _, squad := range squads {
+				items = append(items, listItem{ID: squad.ID, Name: squad.Name})
+			}
+			return items, nil
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func NewWebformListResource() list.ListResource {
+	return &sdkListResource{
+		typeName:           "webform",
+		description:        "webforms",
+		resource:           resourceWebform,
+		identityAttributes: []string{"team_id"},
+		listByName: func(ctx context.Context, client *api.Client, teamID, name string) ([]listItem, error) {
+			webform, err := client.GetWebformByName(ctx, teamID, name)
+			if err != nil {
+				if api.IsResourceNotFoundError(err) {
+					return nil, nil
+				}
+				return nil, err
+			}
+
+			return []listItem{{ID: strconv.FormatUint(uint64(webform.ID), 10), Name: webform.Name}}, nil
+		},
+	}
+}
@@ -4,21 +4,21 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"os"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hasura/go-graphql-client"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
-// initGraphQLClient initializes the graphql client.
-func initGraphQLClient(client api.Client) {
+// initGraphQLClient initializes the graphql client. The access token is read on every request, as
+// the client may authenticate after it is configured.
+func initGraphQLClient(client *api.Client) {
 	graphQLURL := fmt.Sprintf("https://api.%s/v3/graphql", client.Host)
-	bearerToken := fmt.Sprintf("Bearer %s", client.AccessToken)
 	api.GraphQLClient = graphql.NewClient(graphQLURL, nil).WithRequestModifier(func(req *http.Request) {
-		req.Header.Set("Authorization", bearerToken)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", client.AccessToken))
 	})
 }
 
@@ -53,6 +53,12 @@ func New(version string) func() *schema.Provider {
 				"squadcast_schedule_v2": dataSourceScheduleV2(),
 				"squadcast_runbook":     dataSourceRunbook(),
 				"squadcast_webform":     dataSourceWebform(),
+				"squadcast_webhook":     dataSourceWebhook(),
+
+				"squadcast_status_page_statuses": dataSourceStatusPageStatuses(),
+
+				"squadcast_maintenance_calendar": dataSourceMaintenanceCalendar(),
+				"squadcast_organization":         dataSourceOrganization(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
 				"squadcast_apta_config":                  resourceAPTAConfig(),
@@ -68,18 +74,22 @@ func New(version string) func() *schema.Provider {
 				"squadcast_ger_ruleset_rules_ordering":   resourceGERRulesetRulesOrdering(),
 				"squadcast_global_oncall_reminder_rules": resourceGlobalOncallReminderRules(),
 				"squadcast_iag_config":                   resourceIAGConfig(),
+				"squadcast_maintenance_window":           resourceMaintenanceWindow(),
 				"squadcast_routing_rules":                resourceRoutingRules(),
 				"squadcast_routing_rule_v2":              resourceRoutingRuleV2(),
 				"squadcast_runbook":                      resourceRunbook(),
 				"squadcast_schedule":                     resourceSchedule(),
 				"squadcast_schedule_v2":                  resourceScheduleV2(),
-				"squadcast_schedule_rotation_v2":         resourceScheduleRotationV2(),
 				"squadcast_service_maintenance":          resourceServiceMaintenance(),
 				"squadcast_service":                      resourceService(),
+				"squadcast_service_dependency":           resourceServiceDependency(),
+				"squadcast_service_rule_ordering":        resourceServiceRuleOrdering(),
 				"squadcast_squad":                        resourceSquad(),
 				"squadcast_status_page":                  resourceStatusPage(),
 				"squadcast_status_page_component":        resourceStatusPageComponent(),
 				"squadcast_status_page_group":            resourceStatusPageGroup(),
+				"squadcast_status_page_issue_status":     resourceStatusPageIssueStatus(),
+				"squadcast_status_page_maintenance":      resourceStatusPageMaintenance(),
 				"squadcast_suppression_rules":            resourceSuppressionRules(),
 				"squadcast_suppression_rule_v2":          resourceSuppressionRuleV2(),
 				"squadcast_tagging_rules":                resourceTaggingRules(),
@@ -90,8 +100,8 @@ func New(version string) func() *schema.Provider {
 				"squadcast_user":                         resourceUser(),
 				"squadcast_slo":                          resourceSlo(),
 				"squadcast_webform":                      resourceWebform(),
+				"squadcast_webhook":                      resourceWebhook(),
 				"squadcast_workflow":                     resourceWorkflow(),
-				"squadcast_workflow_action":              resourceWorkflowAction(),
 				"squadcast_workflow_action_ordering":     resourceWorkflowActionOrdering(),
 			},
 			Schema: map[string]*schema.Schema{
@@ -100,7 +110,7 @@ func New(version string) func() *schema.Provider {
 						"Supported values are \"us\" and \"eu\"",
 					Type:         schema.TypeString,
 					Optional:     true,
-					DefaultFunc:  schema.EnvDefaultFunc("SQUADCAST_REGION", "us"),
+					DefaultFunc:  schema.EnvDefaultFunc("SQUADCAST_REGION", nil),
 					ValidateFunc: validation.StringInSlice([]string{"us", "eu", "internal", "staging", "dev"}, false),
 				},
 				"refresh_token": {
@@ -110,9 +120,93 @@ func New(version string) func() *schema.Provider {
 					Optional:    true,
 					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_REFRESH_TOKEN", nil),
 				},
+				"refresh_token_file": {
+					Description: "A file containing the refresh token. It can also be set with the `SQUADCAST_REFRESH_TOKEN_FILE` environment variable.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_REFRESH_TOKEN_FILE", nil),
+				},
+				"credential_process": {
+					Description: "A command which prints the credentials as JSON, with either a `refresh_token` or an `access_token`. It is run with the shell, e.g. to read the token from a password manager. It can also be set with the `SQUADCAST_CREDENTIAL_PROCESS` environment variable.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_CREDENTIAL_PROCESS", nil),
+				},
+				"profile": {
+					Description: "The profile of the shared credentials file, which holds a `region` and either a `refresh_token` or a `credential_process`. Defaults to `default`. It can also be set with the `SQUADCAST_PROFILE` environment variable.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_PROFILE", nil),
+				},
+				"shared_credentials_file": {
+					Description: "The path of the shared credentials file. Defaults to `~/.squadcast/credentials`. It can also be set with the `SQUADCAST_SHARED_CREDENTIALS_FILE` environment variable.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_SHARED_CREDENTIALS_FILE", nil),
+				},
+				"default_team_id": {
+					Description:  "The team id used by resources and data sources which do not set `team_id`. It can also be set with the `SQUADCAST_TEAM_ID` environment variable.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("SQUADCAST_TEAM_ID", nil),
+					ValidateFunc: tf.ValidateObjectID,
+				},
+				"skip_credentials_validation": {
+					Description: "Skip the authentication when the provider is configured, it authenticates on its first request instead. A missing or invalid `refresh_token` then only fails once a request is made. It can also be set with the `SQUADCAST_SKIP_CREDENTIALS_VALIDATION` environment variable.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_SKIP_CREDENTIALS_VALIDATION", false),
+				},
+				"read_only": {
+					Description: "Refuse every request which would change Squadcast, while data sources and refreshes still work. It can also be set with the `SQUADCAST_READ_ONLY` environment variable.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_READ_ONLY", false),
+				},
+				"allowed_organization_ids": {
+					Description: "The ids of the organizations the provider is allowed to manage. The provider fails when its credentials belong to another organization.",
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"allowed_organization_slugs": {
+					Description: "The slugs of the organizations the provider is allowed to manage. The provider fails when its credentials belong to another organization.",
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"default_tags": {
+					Description: "Tags merged into the tags of every taggable resource. Tags set on a resource take precedence over the default tags.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"tags": {
+								Description: "The default tags.",
+								Type:        schema.TypeMap,
+								Optional:    true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
 			},
 		}
 
+		for name, r := range p.ResourcesMap {
+			readOnlyResource(name, r)
+		}
+		// Only these resources return the rejected fields of their requests as attribute paths.
+		attributePathResource(p.ResourcesMap["squadcast_escalation_policy"])
+		attributePathResource(p.ResourcesMap["squadcast_webform"])
+
 		p.ConfigureContextFunc = configure(version, p)
 
 		return p
@@ -124,64 +218,53 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		client := &api.Client{}
 		client.UserAgent = p.UserAgent("terraform-provider-squadcast", version)
 
-		region := rd.Get("region").(string)
-		refreshToken := rd.Get("refresh_token").(string)
+		skipCredentialsValidation := rd.Get("skip_credentials_validation").(bool)
 
-		if refreshToken == "" {
-			refreshToken = os.Getenv("SQUADCAST_REFRESH_TOKEN")
-		}
-		if refreshToken == "" {
-			return nil, diag.Errorf("refresh_token is required")
+		creds, err := resolveCredentials(ctx, rd)
+		if err != nil {
+			// Without skip_credentials_validation, missing credentials fail the configuration,
+			// otherwise they fail the first request.
+			if creds == nil || !skipCredentialsValidation {
+				return nil, append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "An error occurred while reading the credentials.",
+					Detail:   err.Error(),
+				})
+			}
+			client.CredentialsError = err
 		}
+		client.RefreshToken = creds.RefreshToken
+		client.AccessToken = creds.AccessToken
 
-		client.RefreshToken = refreshToken
-
-		switch region {
-		case "us":
-			client.Host = "squadcast.com"
-		case "eu":
-			client.Host = "eu.squadcast.com"
-		case "internal":
-			client.Host = "squadcast.xyz"
-		case "staging":
-			client.Host = "squadcast.tech"
-		case "dev":
-			client.Host = "localhost"
+		region := rd.Get("region").(string)
+		if region == "" {
+			region = creds.Region
 		}
-
-		if region == "dev" {
-			client.BaseURLV4 = fmt.Sprintf("http://%s:8081/v4", client.Host)
-			client.BaseURLV3 = fmt.Sprintf("http://%s:8081/v3", client.Host)
-			client.AuthBaseURL = fmt.Sprintf("http://%s:8081/v3", client.Host)
-			client.IngestionBaseURL = fmt.Sprintf("http://%s:8458", client.Host)
-		} else {
-			client.BaseURLV4 = fmt.Sprintf("https://api.%s/v4", client.Host)
-			client.BaseURLV3 = fmt.Sprintf("https://api.%s/v3", client.Host)
-			client.AuthBaseURL = fmt.Sprintf("https://api.%s/v3", client.Host)
-			client.IngestionBaseURL = fmt.Sprintf("https://api.%s", client.Host)
+		if region == "" {
+			region = "us"
 		}
 
-		token, err := client.GetAccessToken(ctx)
-		if err != nil {
-			return nil, append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "An error occurred while fetching the access token.",
-				Detail:   err.Error(),
-			})
-		}
-		client.AccessToken = token.AccessToken
+		client.DefaultTeamID = rd.Get("default_team_id").(string)
+		client.DefaultTags = defaultTags(rd)
+		client.ReadOnly = rd.Get("read_only").(bool)
+		client.AllowedOrganizationIDs = tf.ExpandStringSet(rd.Get("allowed_organization_ids").(*schema.Set))
+		client.AllowedOrganizationSlugs = tf.ExpandStringSet(rd.Get("allowed_organization_slugs").(*schema.Set))
 
-		org, err := client.GetCurrentOrganization(ctx)
-		if err != nil {
-			return nil, append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "An error occurred while fetching the organization.",
-				Detail:   err.Error(),
-			})
+		if err := client.SetRegion(region); err != nil {
+			return nil, diag.FromErr(err)
 		}
-		client.OrganizationID = org.ID
 
-		initGraphQLClient(*client)
+		initGraphQLClient(client)
+
+		if !skipCredentialsValidation {
+			if err := client.Authenticate(ctx); err != nil {
+				return nil, append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "An error occurred while authenticating with Squadcast.",
+					Detail:   err.Error(),
+				})
+			}
+		}
 
 		return client, nil
 	}
@@ -2,29 +2,65 @@ package provider
 
 import (
 	"context"
+	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
 )
 
 var testAccProvider = New("dev")()
 
-// providerFactories are used to instantiate a provider during acceptance testing.
+// protoV5ProviderFactories are used to instantiate the muxed provider during acceptance testing.
 // The factory function will be invoked for every Terraform CLI command executed
 // to create a provider server to which the CLI can reattach.
-var providerFactories = map[string]func() (*schema.Provider, error){
-	"squadcast": func() (*schema.Provider, error) {
-		return New("dev")(), nil
+var protoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"squadcast": func() (tfprotov5.ProviderServer, error) {
+		serverFactory, err := ProtoV5ProviderServerFactory(context.Background(), "dev")
+		if err != nil {
+			return nil, err
+		}
+		return serverFactory(), nil
 	},
 }
 
+// testAccFunctionConfig declares the provider, which is required to call its functions.
+const testAccFunctionConfig = `
+terraform {
+	required_providers {
+		squadcast = {
+			source = "hashicorp/squadcast"
+		}
+	}
+}
+`
+
 func TestProvider(t *testing.T) {
 	if err := New("dev")().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 }
 
+func TestProviderSkipCredentialsValidation(t *testing.T) {
+	t.Setenv("SQUADCAST_REFRESH_TOKEN", "")
+	t.Setenv("SQUADCAST_SHARED_CREDENTIALS_FILE", "")
+	t.Setenv("HOME", t.TempDir())
+
+	p := New("dev")()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{
+		"skip_credentials_validation": true,
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	client := p.Meta().(*api.Client)
+	if err := client.Authenticate(context.Background()); err == nil || !strings.HasPrefix(err.Error(), "no credentials found") {
+		t.Fatalf("expected the missing refresh_token to fail the authentication, got %v", err)
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	// You can add code here to run prior to any test case execution, for example assertions
 	// about the appropriate environment variables being set are common to see in a pre-check
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+// readOnlyResource makes the create, update and delete functions of a resource fail before any
+// request is made when read_only is set on the provider. The client refuses such requests too,
+// this names the resource in the diagnostic.
+func readOnlyResource(name string, r *schema.Resource) {
+	r.CreateContext = readOnlyGuard(name, "create", r.CreateContext)
+	r.UpdateContext = readOnlyGuard(name, "update", r.UpdateContext)
+	r.DeleteContext = readOnlyGuard(name, "delete", r.DeleteContext)
+}
+
+func readOnlyGuard[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](name, operation string, f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		if client, ok := meta.(*api.Client); ok && client.ReadOnly {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  readOnlySummary,
+				Detail:   readOnlyDetail(name, operation, d.Id()),
+			}}
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
+// readOnlyFrameworkGuard is the guard of readOnlyResource for plugin framework resources, which
+// their create, update and delete methods call before any request. It returns false after adding
+// an error to diags when read_only is set on the provider.
+func readOnlyFrameworkGuard(client *api.Client, name, operation, id string, diags *fwdiag.Diagnostics) bool {
+	if client != nil && client.ReadOnly {
+		diags.AddError(readOnlySummary, readOnlyDetail(name, operation, id))
+		return false
+	}
+
+	return true
+}
+
+const readOnlySummary = "The provider is read only"
+
+func readOnlyDetail(name, operation, id string) string {
+	resource := name
+	if id != "" {
+		resource += " " + id
+	}
+	return fmt.Sprintf("Refusing to %s %s, read_only is set on the provider.", operation, resource)
+}
@@ -13,9 +13,9 @@ import (
 func TestAccResourceAPTAConfig(t *testing.T) {
 	resourceName := "squadcast_apta_config.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckAPTAConfigDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPTAConfigDestroy,
 		Steps: []resource.TestStep{
 			{
 				// Test basic configuration
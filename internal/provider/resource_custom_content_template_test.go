@@ -13,9 +13,9 @@ import (
 func TestAccResourceCustomContentTemplate(t *testing.T) {
 	resourceName := "squadcast_custom_content_template.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCustomContentTemplateDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomContentTemplateDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceCustomContentTemplateConfig_defaults(),
@@ -13,9 +13,9 @@ import (
 func TestAccResourceDedupKeyOverlay(t *testing.T) {
 	resourceName := "squadcast_dedup_key_overlay.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckDedupKeyOverlayDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDedupKeyOverlayDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDedupKeyOverlayConfig_defaults(),
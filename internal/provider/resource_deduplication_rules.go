@@ -21,6 +21,7 @@ func resourceDeduplicationRules() *schema.Resource {
 		ReadContext:   resourceDeduplicationRulesRead,
 		UpdateContext: resourceDeduplicationRulesUpdate,
 		DeleteContext: resourceDeduplicationRulesDelete,
+		CustomizeDiff: customizeDiffDefaultTeamID,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceDeduplicationRulesImport,
 		},
@@ -32,9 +33,10 @@ func resourceDeduplicationRules() *schema.Resource {
 				Computed:    true,
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
@@ -13,9 +13,9 @@ import (
 func TestAccResourceDeduplicationRules(t *testing.T) {
 	resourceName := "squadcast_deduplication_rules.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckDeduplicationRulesDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeduplicationRulesDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDeduplicationRulesConfig_defaults(),
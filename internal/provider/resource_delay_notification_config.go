@@ -43,9 +43,11 @@ func resourceDelayedNotificationConfig() *schema.Resource {
 				Description: "Enable delay notification",
 			},
 			"timezone": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Timezone",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Timezone, an IANA time zone, e.g. 'Asia/Kolkata'.",
+				ValidateFunc:     tf.ValidateTimezone,
+				DiffSuppressFunc: tf.SuppressEquivalentTimezone,
 			},
 			"fixed_timeslot_config": {
 				Type:        schema.TypeList,
@@ -219,7 +221,7 @@ func decodeDelayNotificationConfig(d *schema.ResourceData) (*api.NotificationsDe
 	isCustomTimeSlotEnabled := delayNotifConfigMap["custom_timeslots_enabled"].(bool)
 	cfg := &api.NotificationsDelayConfig{
 		IsEnabled:              delayNotifConfigMap["is_enabled"].(bool),
-		Timezone:               delayNotifConfigMap["timezone"].(string),
+		Timezone:               tf.CanonicalTimezone(delayNotifConfigMap["timezone"].(string)),
 		CustomTimeslotsEnabled: isCustomTimeSlotEnabled,
 	}
 
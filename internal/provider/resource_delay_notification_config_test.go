@@ -15,9 +15,9 @@ func TestAccResourceDelayedNotificationConfig(t *testing.T) {
 	customResourceName := "squadcast_delayed_notification_config.custom_test"
 
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckDelayedNotificationConfigDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDelayedNotificationConfigDestroy,
 		Steps: []resource.TestStep{
 			{
 				// Test fixed timeslot configuration
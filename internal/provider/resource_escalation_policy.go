@@ -21,9 +21,11 @@ func resourceEscalationPolicy() *schema.Resource {
 		ReadContext:   resourceEscalationPolicyRead,
 		UpdateContext: resourceEscalationPolicyUpdate,
 		DeleteContext: resourceEscalationPolicyDelete,
+		CustomizeDiff: customizeDiffDefaultTeamID,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceEscalationPolicyImport,
 		},
+		Identity: teamResourceIdentity(),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -44,9 +46,10 @@ func resourceEscalationPolicy() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 1000),
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
@@ -193,11 +196,16 @@ func resourceEscalationPolicy() *schema.Resource {
 					},
 				},
 			},
+			"adopt_existing": adoptExistingSchema("escalation policy"),
 		},
 	}
 }
 
 func resourceEscalationPolicyImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		return importIdentity(d, "team_id")
+	}
+
 	client := meta.(*api.Client)
 
 	teamID, name, err := parse2PartImportID(d.Id())
@@ -318,7 +326,19 @@ func resourceEscalationPolicyCreate(ctx context.Context, d *schema.ResourceData,
 
 	escalationPolicy, err := client.CreateEscalationPolicy(ctx, req)
 	if err != nil {
-		return diag.FromErr(err)
+		id, err := adoptExisting(ctx, d.Get("adopt_existing").(bool), "escalation policy", req.Name, err, func() (string, error) {
+			escalationPolicy, err := client.GetEscalationPolicyByName(ctx, req.TeamID, req.Name)
+			if err != nil {
+				return "", err
+			}
+			return escalationPolicy.ID, nil
+		})
+		if err != nil {
+			return diagFromAPIError(err)
+		}
+
+		d.SetId(id)
+		return resourceEscalationPolicyUpdate(ctx, d, meta)
 	}
 
 	d.SetId(escalationPolicy.ID)
@@ -353,6 +373,10 @@ func resourceEscalationPolicyRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	if err = setIdentity(d, "team_id"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -366,7 +390,7 @@ func resourceEscalationPolicyUpdate(ctx context.Context, d *schema.ResourceData,
 
 	_, err = client.UpdateEscalationPolicy(ctx, d.Id(), req)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromAPIError(err)
 	}
 
 	return resourceEscalationPolicyRead(ctx, d, meta)
@@ -16,9 +16,9 @@ func TestAccResourceEscalationPolicy(t *testing.T) {
 
 	resourceName := "squadcast_escalation_policy.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckEscalationPolicyDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEscalationPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceEscalationPolicyConfig(escalationPolicyName),
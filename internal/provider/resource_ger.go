@@ -20,9 +20,11 @@ func resourceGER() *schema.Resource {
 		ReadContext:   resourceGERRead,
 		UpdateContext: resourceGERUpdate,
 		DeleteContext: resourceGERDelete,
+		CustomizeDiff: customizeDiffDefaultTeamID,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceGERImport,
 		},
+		Identity: idResourceIdentity(),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -31,9 +33,10 @@ func resourceGER() *schema.Resource {
 				Computed:    true,
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 			},
 			"name": {
@@ -79,6 +82,10 @@ func resourceGER() *schema.Resource {
 }
 
 func resourceGERImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		return importIdentity(d)
+	}
+
 	d.SetId(d.Id())
 
 	return []*schema.ResourceData{d}, nil
@@ -140,6 +147,10 @@ func resourceGERRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 		return diag.FromErr(err)
 	}
 
+	if err = setIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -19,6 +19,7 @@ func resourceGlobalOncallReminderRules() *schema.Resource {
 		ReadContext:   resourceGlobalOncallReminderRulesRead,
 		UpdateContext: resourceGlobalOncallReminderRulesUpdate,
 		DeleteContext: resourceGlobalOncallReminderRulesDelete,
+		CustomizeDiff: customizeDiffDefaultTeamID,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceGlobalOncallReminderRulesImport,
 		},
@@ -30,9 +31,10 @@ func resourceGlobalOncallReminderRules() *schema.Resource {
 				Computed:    true,
 			},
 			"team_id": {
-				Description:  "Team ID.",
+				Description:  "Team ID. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
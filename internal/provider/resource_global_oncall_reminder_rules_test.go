@@ -20,9 +20,9 @@ func TestAccResourceGlobalOncallReminderRules(t *testing.T) {
 	teamResourceName := "squadcast_team.test"
 	resourceName := "squadcast_global_oncall_reminder_rules.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckGlobalOncallReminderRulesDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlobalOncallReminderRulesDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceTeamMemberConfig(teamName, user),
@@ -63,7 +63,7 @@ func testAccCheckGlobalOncallReminderRulesDestroy(s *terraform.State) error {
 
 		_, err := client.DeleteGlobalOncallReminderRules(context.Background(), rs.Primary.Attributes["team_id"])
 		if err != nil {
-			return fmt.Errorf(err.Error())
+			return err
 		}
 
 		if !api.IsResourceNotFoundError(err) {
@@ -13,9 +13,9 @@ import (
 func TestAccResourceIAGConfig(t *testing.T) {
 	resourceName := "squadcast_iag_config.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckIAGConfigDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIAGConfigDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceIAGConfigBasic(),
@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// serviceMaintenanceMutex serialises maintenance window updates per service, since the API only
+// accepts the complete list of windows of a service.
+var serviceMaintenanceMutex = tf.NewMutexKV()
+
+func resourceMaintenanceWindow() *schema.Resource {
+	return &schema.Resource{
+		Description: "A [maintenance window](https://support.squadcast.com/docs/maintenance-mode) applied to many services at once, either by service id or by a service tag selector. Only this window is added to or removed from each service, windows managed elsewhere are preserved. As windows have no owner, adding the window fails on a service which already has an identical one. Do not combine this resource with `squadcast_service_maintenance` on the same service, as that resource manages the complete list of windows.",
+
+		CreateContext: resourceMaintenanceWindowCreate,
+		ReadContext:   resourceMaintenanceWindowRead,
+		UpdateContext: resourceMaintenanceWindowUpdate,
+		DeleteContext: resourceMaintenanceWindowDelete,
+		CustomizeDiff: resourceMaintenanceWindowCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"service_ids": {
+				Description:  "Ids of the services to put under maintenance.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				MinItems:     1,
+				ExactlyOneOf: []string{"service_ids", "service_selector"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: tf.ValidateObjectID,
+				},
+			},
+			"service_selector": {
+				Description:  "Selects the services of a team to put under maintenance by their tags.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"service_ids", "service_selector"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"team_id": {
+							Description:  "Team id. Defaults to the `default_team_id` of the provider.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: tf.ValidateObjectID,
+						},
+						"tags": {
+							Description: "Service tags which all need to match for a service to be selected.",
+							Type:        schema.TypeMap,
+							Required:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"from": {
+				Description:  "Starting Time",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"till": {
+				Description:  "End Time.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"repeat_till": {
+				Description:  "Till when you want to repeat this Maintenance mode. Required when `repeat_frequency` is set.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"repeat_frequency": {
+				Description:  "repeat frequency. ('day', 'week', '2 weeks', '3 weeks', 'month')",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"day", "week", "2 weeks", "3 weeks", "month"}, false),
+				RequiredWith: []string{"repeat_till"},
+			},
+			"target_service_ids": {
+				Description: "Ids of the services this window is applied to.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func maintenanceWindowFromResourceData(d interface{ Get(string) any }) *api.ServiceMaintenanceWindow {
+	return &api.ServiceMaintenanceWindow{
+		From:            d.Get("from").(string),
+		Till:            d.Get("till").(string),
+		RepeatTill:      d.Get("repeat_till").(string),
+		RepeatFrequency: d.Get("repeat_frequency").(string),
+	}
+}
+
+// resolveMaintenanceWindowTargets returns the ids of the services selected by the configuration.
+func resolveMaintenanceWindowTargets(ctx context.Context, client *api.Client, serviceIDs *schema.Set, selector []any) ([]string, error) {
+	if len(selector) == 0 || selector[0] == nil {
+		targets := tf.ExpandStringSet(serviceIDs)
+		sort.Strings(targets)
+		return targets, nil
+	}
+
+	mselector := selector[0].(map[string]any)
+	tags := mselector["tags"].(map[string]any)
+
+	teamID := mselector["team_id"].(string)
+	if teamID == "" {
+		if client.DefaultTeamID == "" {
+			return nil, errTeamIDRequired
+		}
+		teamID = client.DefaultTeamID
+	}
+
+	services, err := client.ListServices(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := []string{}
+	for _, service := range services {
+		matched := 0
+		for _, tag := range service.Tags {
+			if v, ok := tags[tag.Key]; ok && v.(string) == tag.Value {
+				matched++
+			}
+		}
+		if matched == len(tags) {
+			targets = append(targets, service.ID)
+		}
+	}
+	sort.Strings(targets)
+
+	return targets, nil
+}
+
+func resourceMaintenanceWindowCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("service_ids") || !d.NewValueKnown("service_selector") {
+		return d.SetNewComputed("target_service_ids")
+	}
+
+	targets, err := resolveMaintenanceWindowTargets(ctx, meta.(*api.Client), d.Get("service_ids").(*schema.Set), d.Get("service_selector").([]any))
+	if err != nil {
+		return err
+	}
+
+	current := tf.ExpandStringSet(d.Get("target_service_ids").(*schema.Set))
+	sort.Strings(current)
+	if fmt.Sprint(current) != fmt.Sprint(targets) {
+		return d.SetNew("target_service_ids", targets)
+	}
+
+	return nil
+}
+
+func resourceMaintenanceWindowCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	targets, err := resolveMaintenanceWindowTargets(ctx, client, d.Get("service_ids").(*schema.Set), d.Get("service_selector").([]any))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating maintenance window", tf.M{
+		"services": targets,
+	})
+
+	d.SetId(id.UniqueId())
+
+	added, diags := addMaintenanceWindow(ctx, client, maintenanceWindowFromResourceData(d), targets)
+	if err := d.Set("target_service_ids", added); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, resourceMaintenanceWindowRead(ctx, d, meta)...)
+}
+
+func resourceMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading maintenance window", tf.M{
+		"id": d.Id(),
+	})
+
+	window := maintenanceWindowFromResourceData(d)
+
+	var diags diag.Diagnostics
+	targets := []string{}
+	for _, serviceID := range tf.ExpandStringSet(d.Get("target_service_ids").(*schema.Set)) {
+		windows, err := client.GetServiceMaintenanceWindows(ctx, serviceID)
+		if err != nil {
+			if api.IsResourceNotFoundError(err) {
+				continue
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Unable to read the maintenance windows of service %s", serviceID),
+				Detail:   err.Error(),
+			})
+			targets = append(targets, serviceID)
+			continue
+		}
+
+		for _, w := range windows {
+			if w.Equal(window) {
+				targets = append(targets, serviceID)
+				break
+			}
+		}
+	}
+
+	if err := d.Set("target_service_ids", targets); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceMaintenanceWindowUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	targets, err := resolveMaintenanceWindowTargets(ctx, client, d.Get("service_ids").(*schema.Set), d.Get("service_selector").([]any))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldTargets, _ := d.GetChange("target_service_ids")
+	window := maintenanceWindowFromResourceData(d)
+
+	var diags diag.Diagnostics
+	var kept, added []string
+	if d.HasChanges("from", "till", "repeat_till", "repeat_frequency") {
+		oldWindow := &api.ServiceMaintenanceWindow{}
+		for key, field := range map[string]*string{
+			"from":             &oldWindow.From,
+			"till":             &oldWindow.Till,
+			"repeat_till":      &oldWindow.RepeatTill,
+			"repeat_frequency": &oldWindow.RepeatFrequency,
+		} {
+			o, _ := d.GetChange(key)
+			*field = o.(string)
+		}
+		diags = append(diags, removeMaintenanceWindow(ctx, client, oldWindow, tf.ExpandStringSet(oldTargets.(*schema.Set)))...)
+
+		var addDiags diag.Diagnostics
+		added, addDiags = addMaintenanceWindow(ctx, client, window, targets)
+		diags = append(diags, addDiags...)
+	} else {
+		// The window is unchanged, so it is only added to the services which are new targets.
+		wasTarget := make(map[string]bool)
+		for _, serviceID := range tf.ExpandStringSet(oldTargets.(*schema.Set)) {
+			wasTarget[serviceID] = true
+		}
+		isTarget := make(map[string]bool, len(targets))
+		newTargets := []string{}
+		for _, serviceID := range targets {
+			isTarget[serviceID] = true
+			if wasTarget[serviceID] {
+				kept = append(kept, serviceID)
+			} else {
+				newTargets = append(newTargets, serviceID)
+			}
+		}
+		removed := []string{}
+		for serviceID := range wasTarget {
+			if !isTarget[serviceID] {
+				removed = append(removed, serviceID)
+			}
+		}
+		sort.Strings(removed)
+		diags = append(diags, removeMaintenanceWindow(ctx, client, window, removed)...)
+
+		var addDiags diag.Diagnostics
+		added, addDiags = addMaintenanceWindow(ctx, client, window, newTargets)
+		diags = append(diags, addDiags...)
+	}
+
+	if err := d.Set("target_service_ids", append(kept, added...)); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, resourceMaintenanceWindowRead(ctx, d, meta)...)
+}
+
+func resourceMaintenanceWindowDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	return removeMaintenanceWindow(ctx, client, maintenanceWindowFromResourceData(d), tf.ExpandStringSet(d.Get("target_service_ids").(*schema.Set)))
+}
+
+// addMaintenanceWindow adds the window to each service, reporting failures per service. It returns
+// the services the window was added to.
+func addMaintenanceWindow(ctx context.Context, client *api.Client, window *api.ServiceMaintenanceWindow, serviceIDs []string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	added := []string{}
+	for _, serviceID := range serviceIDs {
+		serviceMaintenanceMutex.Lock(serviceID)
+		err := client.AddServiceMaintenanceWindow(ctx, serviceID, window)
+		serviceMaintenanceMutex.Unlock(serviceID)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Unable to add the maintenance window to service %s", serviceID),
+				Detail:   err.Error(),
+			})
+			continue
+		}
+		added = append(added, serviceID)
+	}
+	return added, diags
+}
+
+// removeMaintenanceWindow removes the window from each service, reporting failures per service.
+func removeMaintenanceWindow(ctx context.Context, client *api.Client, window *api.ServiceMaintenanceWindow, serviceIDs []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, serviceID := range serviceIDs {
+		serviceMaintenanceMutex.Lock(serviceID)
+		err := client.RemoveServiceMaintenanceWindow(ctx, serviceID, window)
+		serviceMaintenanceMutex.Unlock(serviceID)
+		if err != nil && !api.IsResourceNotFoundError(err) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Unable to remove the maintenance window from service %s", serviceID),
+				Detail:   err.Error(),
+			})
+		}
+	}
+	return diags
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceMaintenanceWindow(t *testing.T) {
+	resourceName := "squadcast_maintenance_window.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMaintenanceWindowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMaintenanceWindowConfig("2032-06-01T10:30:00Z", "2032-06-01T11:30:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "service_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_service_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_service_ids.0", "61361611c2fc70c3101ca7dd"),
+					resource.TestCheckResourceAttr(resourceName, "from", "2032-06-01T10:30:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "till", "2032-06-01T11:30:00Z"),
+				),
+			},
+			{
+				Config: testAccResourceMaintenanceWindowConfig("2032-07-01T10:30:00Z", "2032-07-02T10:30:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "target_service_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "from", "2032-07-01T10:30:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "till", "2032-07-02T10:30:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceMaintenanceWindowServiceSelector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v3/organization":
+			w.Write([]byte(`{"data": {"id": "org1", "slug": "org"}}`))
+		case "/v3/services":
+			if owner := r.URL.Query().Get("owner_id"); owner != "613611c1eb22db455cfa789f" {
+				t.Errorf("got services of team %q", owner)
+			}
+			w.Write([]byte(`{"data": [
+				{"id": "5f0000000000000000000001", "tags": [{"key": "env", "value": "prod"}, {"key": "tier", "value": "db"}]},
+				{"id": "5f0000000000000000000002", "tags": [{"key": "env", "value": "prod"}]},
+				{"id": "5f0000000000000000000003", "tags": [{"key": "env", "value": "staging"}, {"key": "tier", "value": "db"}]},
+				{"id": "5f0000000000000000000004", "tags": [{"key": "tier", "value": "db"}, {"key": "env", "value": "prod"}]}
+			]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{AccessToken: "token", BaseURLV3: server.URL + "/v3"}
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"service_selector": []any{map[string]any{
+			"team_id": "613611c1eb22db455cfa789f",
+			"tags":    map[string]any{"env": "prod", "tier": "db"},
+		}},
+		"from": "2032-06-01T10:30:00Z",
+		"till": "2032-06-01T11:30:00Z",
+	})
+
+	diff, err := resourceMaintenanceWindow().Diff(context.Background(), nil, config, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var targets []string
+	for key, attr := range diff.Attributes {
+		if strings.HasPrefix(key, "target_service_ids.") && key != "target_service_ids.#" {
+			targets = append(targets, attr.New)
+		}
+	}
+	slices.Sort(targets)
+	if want := []string{"5f0000000000000000000001", "5f0000000000000000000004"}; !slices.Equal(targets, want) {
+		t.Errorf("got target services %v, want %v", targets, want)
+	}
+}
+
+func TestResourceMaintenanceWindowRepeatTillRequired(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"service_ids":      []any{"61361611c2fc70c3101ca7dd"},
+		"from":             "2032-06-01T10:30:00Z",
+		"till":             "2032-06-01T11:30:00Z",
+		"repeat_frequency": "week",
+	})
+
+	diags := resourceMaintenanceWindow().Validate(config)
+	if !diags.HasError() || !strings.Contains(fmt.Sprint(diags), "repeat_till") {
+		t.Errorf("got %v, want repeat_till to be required", diags)
+	}
+}
+
+func testAccCheckMaintenanceWindowDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_maintenance_window" {
+			continue
+		}
+
+		window := &api.ServiceMaintenanceWindow{
+			From: rs.Primary.Attributes["from"],
+			Till: rs.Primary.Attributes["till"],
+		}
+		windows, err := client.GetServiceMaintenanceWindows(context.Background(), "61361611c2fc70c3101ca7dd")
+		if err != nil {
+			return err
+		}
+		for _, w := range windows {
+			if w.Equal(window) {
+				return fmt.Errorf("expected maintenance window to be destroyed, %s found", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceMaintenanceWindowConfig(from, till string) string {
+	return fmt.Sprintf(`
+resource "squadcast_maintenance_window" "test" {
+	service_ids = ["61361611c2fc70c3101ca7dd"]
+
+	from = "%s"
+	till = "%s"
+}
+	`, from, till)
+}
@@ -22,6 +22,7 @@ func resourceRoutingRules() *schema.Resource {
 		ReadContext:   resourceRoutingRulesRead,
 		UpdateContext: resourceRoutingRulesUpdate,
 		DeleteContext: resourceRoutingRulesDelete,
+		CustomizeDiff: customizeDiffDefaultTeamID,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceRoutingRulesImport,
 		},
@@ -33,9 +34,10 @@ func resourceRoutingRules() *schema.Resource {
 				Computed:    true,
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
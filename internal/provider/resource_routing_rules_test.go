@@ -13,9 +13,9 @@ import (
 func TestAccResourceRoutingRules(t *testing.T) {
 	resourceName := "squadcast_routing_rules.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckRoutingRulesDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoutingRulesDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceRoutingRulesConfig(),
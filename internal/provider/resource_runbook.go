@@ -19,9 +19,11 @@ func resourceRunbook() *schema.Resource {
 		ReadContext:   resourceRunbookRead,
 		UpdateContext: resourceRunbookUpdate,
 		DeleteContext: resourceRunbookDelete,
+		CustomizeDiff: customizeDiffDefaultTeamID,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceRunbookImport,
 		},
+		Identity: teamResourceIdentity(),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -36,9 +38,10 @@ func resourceRunbook() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 1000),
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
@@ -83,6 +86,10 @@ func resourceRunbook() *schema.Resource {
 }
 
 func resourceRunbookImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		return importIdentity(d, "team_id")
+	}
+
 	client := meta.(*api.Client)
 
 	teamID, name, err := parse2PartImportID(d.Id())
@@ -168,6 +175,10 @@ func resourceRunbookRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return diag.FromErr(err)
 	}
 
+	if err = setIdentity(d, "team_id"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
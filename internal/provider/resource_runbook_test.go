@@ -16,9 +16,9 @@ func TestAccResourceRunbook(t *testing.T) {
 
 	resourceName := "squadcast_runbook.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckRunbookDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRunbookDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceRunbookConfig(runbookName),
@@ -19,6 +19,7 @@ func resourceSchedule() *schema.Resource {
 		ReadContext:   resourceScheduleRead,
 		UpdateContext: resourceScheduleUpdate,
 		DeleteContext: resourceScheduleDelete,
+		CustomizeDiff: customizeDiffDefaultTeamID,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceScheduleImport,
 		},
@@ -42,9 +43,10 @@ func resourceSchedule() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 1000),
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
@@ -2,69 +2,190 @@ package provider
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
-func resourceScheduleRotationV2() *schema.Resource {
-	return &schema.Resource{
-		Description:   "[Schedule rotations](https://support.squadcast.com/schedules/schedules-new/adding-a-schedule#2.-choose-a-rotation-pattern) are used to manage on-call scheduling & determine who will be notified when an incident is triggered. The name of the Rotation must be unique within a Schedule.",
-		ReadContext:   resourceScheduleRotationV2Read,
-		CreateContext: resourceScheduleRotationV2Create,
-		UpdateContext: resourceScheduleRotationV2Update,
-		DeleteContext: resourceScheduleRotationV2Delete,
-		Importer: &schema.ResourceImporter{
-			StateContext: resourceScheduleRotationV2Import,
-		},
-		Schema: map[string]*schema.Schema{
-			"id": {
-				Description: "Rotation id.",
-				Type:        schema.TypeString,
-				Computed:    true,
+var (
+	_ resource.ResourceWithConfigure      = &scheduleRotationV2Resource{}
+	_ resource.ResourceWithImportState    = &scheduleRotationV2Resource{}
+	_ resource.ResourceWithValidateConfig = &scheduleRotationV2Resource{}
+	_ resource.ResourceWithModifyPlan     = &scheduleRotationV2Resource{}
+)
+
+type scheduleRotationV2Resource struct {
+	client *api.Client
+}
+
+type scheduleRotationV2Model struct {
+	ID                          types.String            `tfsdk:"id"`
+	ScheduleID                  types.Int64             `tfsdk:"schedule_id"`
+	Name                        types.String            `tfsdk:"name"`
+	ParticipantGroups           []participantGroupModel `tfsdk:"participant_groups"`
+	StartDate                   types.String            `tfsdk:"start_date"`
+	Period                      types.String            `tfsdk:"period"`
+	ShiftTimeslots              []shiftTimeslotModel    `tfsdk:"shift_timeslots"`
+	CustomPeriodFrequency       types.Int64             `tfsdk:"custom_period_frequency"`
+	CustomPeriodUnit            types.String            `tfsdk:"custom_period_unit"`
+	ChangeParticipantsFrequency types.Int64             `tfsdk:"change_participants_frequency"`
+	ChangeParticipantsUnit      types.String            `tfsdk:"change_participants_unit"`
+	EndDate                     types.String            `tfsdk:"end_date"`
+	EndsAfterIterations         types.Int64             `tfsdk:"ends_after_iterations"`
+	AdoptExisting               types.Bool              `tfsdk:"adopt_existing"`
+}
+
+type participantGroupModel struct {
+	Participants []participantModel `tfsdk:"participants"`
+}
+
+type participantModel struct {
+	Type types.String `tfsdk:"type"`
+	ID   types.String `tfsdk:"id"`
+}
+
+type shiftTimeslotModel struct {
+	StartHour   types.Int64  `tfsdk:"start_hour"`
+	StartMinute types.Int64  `tfsdk:"start_minute"`
+	Duration    types.Int64  `tfsdk:"duration"`
+	DayOfWeek   types.String `tfsdk:"day_of_week"`
+}
+
+func NewScheduleRotationV2Resource() resource.Resource {
+	return &scheduleRotationV2Resource{}
+}
+
+func (r *scheduleRotationV2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule_rotation_v2"
+}
+
+func (r *scheduleRotationV2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "[Schedule rotations](https://support.squadcast.com/schedules/schedules-new/adding-a-schedule#2.-choose-a-rotation-pattern) are used to manage on-call scheduling & determine who will be notified when an incident is triggered. The name of the Rotation must be unique within a Schedule.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Rotation id.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"schedule_id": {
-				Description: "id of the schedule that the rotation belongs to.",
-				Type:        schema.TypeInt,
-				Required:    true,
+			"schedule_id": schema.Int64Attribute{
+				MarkdownDescription: "id of the schedule that the rotation belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					// Imported rotations do not know their schedule yet.
+					int64planmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.StateValue.IsNull()
+					}, "Moving a rotation to another schedule requires the rotation to be recreated.", "Moving a rotation to another schedule requires the rotation to be recreated."),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Rotation name.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 150),
+				},
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "Defines the start date of the rotation in UTC. Format: YYYY-MM-DDT00:00:00Z (time must be exactly 00:00:00).",
+				Required:            true,
+			},
+			"period": schema.StringAttribute{
+				MarkdownDescription: "Rotation period (none, daily, weekly, monthly, custom). Defines how often the rotation repeats.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "daily", "weekly", "monthly", "custom"),
+				},
+			},
+			"custom_period_frequency": schema.Int64Attribute{
+				MarkdownDescription: "Frequency of the custom rotation repeat pattern. Only applicable if period is set to custom.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"custom_period_unit": schema.StringAttribute{
+				MarkdownDescription: "Unit of the custom rotation repeat pattern (day, week). Only applicable if period is set to custom.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("day", "week"),
+				},
+			},
+			"change_participants_frequency": schema.Int64Attribute{
+				MarkdownDescription: "Frequency with which participants change in the rotation.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"change_participants_unit": schema.StringAttribute{
+				MarkdownDescription: "Unit of the frequency with which participants change in the rotation (rotation, day, week, month).",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("rotation", "day", "week", "month"),
+				},
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "Defines the end date of the schedule rotation.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ends_after_iterations": schema.Int64Attribute{
+				MarkdownDescription: "Defines the number of iterations of the schedule rotation.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.MatchRoot("end_date")),
+				},
 			},
-			"name": {
-				Description:  "Rotation name.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(1, 150),
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf(adoptExistingDescription, "rotation", "rotation"),
+				Optional:            true,
 			},
-			"participant_groups": {
-				Description: "Ordered list of participant groups for the rotation. For each rotation the participant_groups are cycled through in order.",
-				Type:        schema.TypeList,
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"participants": {
-							Description: "Group participants.",
-							Type:        schema.TypeList,
-							Optional:    true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"type": {
-										Description:  "Participant type (user, team, squad).",
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice([]string{"user", "squad", "team"}, false),
+		},
+
+		Blocks: map[string]schema.Block{
+			"participant_groups": schema.ListNestedBlock{
+				MarkdownDescription: "Ordered list of participant groups for the rotation. For each rotation the participant_groups are cycled through in order.",
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"participants": schema.ListNestedBlock{
+							MarkdownDescription: "Group participants.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										MarkdownDescription: "Participant type (user, team, squad).",
+										Required:            true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("user", "squad", "team"),
+										},
 									},
-									"id": {
-										Description:  "Participant id.",
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: tf.ValidateObjectID,
+									"id": schema.StringAttribute{
+										MarkdownDescription: "Participant id.",
+										Required:            true,
+										Validators: []validator.String{
+											tf.ObjectIDValidator(),
+										},
 									},
 								},
 							},
@@ -72,344 +193,371 @@ func resourceScheduleRotationV2() *schema.Resource {
 					},
 				},
 			},
-			"start_date": {
-				Description: "Defines the start date of the rotation in UTC. Format: YYYY-MM-DDT00:00:00Z (time must be exactly 00:00:00).",
-				Type:        schema.TypeString,
-				Required:    true,
-			},
-			"period": {
-				Description:  "Rotation period (none, daily, weekly, monthly, custom). Defines how often the rotation repeats.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"none", "daily", "weekly", "monthly", "custom"}, false),
-			},
-			"shift_timeslots": {
-				Description: "Timeslots where the rotation is active.",
-				Type:        schema.TypeList,
-				Required:    true,
-				MinItems:    1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"start_hour": {
-							Description:  "Defines the start hour of the each shift in the schedule timezone.",
-							Type:         schema.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(0, 23),
+			"shift_timeslots": schema.ListNestedBlock{
+				MarkdownDescription: "Timeslots where the rotation is active. At least one timeslot is required.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"start_hour": schema.Int64Attribute{
+							MarkdownDescription: "Defines the start hour of the each shift in the schedule timezone.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 23),
+							},
 						},
-						"start_minute": {
-							Description:  "Defines the start minute of the each shift in the schedule timezone.",
-							Type:         schema.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(0, 59),
+						"start_minute": schema.Int64Attribute{
+							MarkdownDescription: "Defines the start minute of the each shift in the schedule timezone.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 59),
+							},
 						},
-						"duration": {
-							Description:  "Defines the duration of each shift. (in minutes)",
-							Type:         schema.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(1, 1440),
+						"duration": schema.Int64Attribute{
+							MarkdownDescription: "Defines the duration of each shift. (in minutes)",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 1440),
+							},
 						},
-						"day_of_week": {
-							Description:  "Defines the day of the week for the shift. If not specified, the timeslot is active on all days of the week.",
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringInSlice([]string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}, false),
+						"day_of_week": schema.StringAttribute{
+							MarkdownDescription: "Defines the day of the week for the shift. If not specified, the timeslot is active on all days of the week.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"),
+							},
 						},
 					},
 				},
 			},
-			"custom_period_frequency": {
-				Description:  "Frequency of the custom rotation repeat pattern. Only applicable if period is set to custom.",
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ValidateFunc: validation.IntBetween(1, 100),
-			},
-			"custom_period_unit": {
-				Description:  "Unit of the custom rotation repeat pattern (day, week). Only applicable if period is set to custom.",
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"day", "week"}, false),
-			},
-			"change_participants_frequency": {
-				Description:  "Frequency with which participants change in the rotation.",
-				Type:         schema.TypeInt,
-				Required:     true,
-				ValidateFunc: validation.IntBetween(1, 100),
-			},
-			"change_participants_unit": {
-				Description:  "Unit of the frequency with which participants change in the rotation (rotation, day, week, month).",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"rotation", "day", "week", "month"}, false),
-			},
-			"end_date": {
-				Description: "Defines the end date of the schedule rotation.",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-			},
-			"ends_after_iterations": {
-				Description: "Defines the number of iterations of the schedule rotation.",
-				Type:        schema.TypeInt,
-				Optional:    true,
-			},
 		},
 	}
 }
-func parse3PartImportID(id string) (string, string, string, error) {
-	parts := strings.SplitN(id, ":", 3)
 
-	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
-		return "", "", "", fmt.Errorf("unexpected format of import resource id (%s), expected teamID:ID", id)
+func (r *scheduleRotationV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
 	}
-
-	return parts[0], parts[1], parts[2], nil
+	r.client = req.ProviderData.(*api.Client)
 }
 
-func resourceScheduleRotationV2Import(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	client := meta.(*api.Client)
-	teamID, scheduleName, rotationName, err := parse3PartImportID(d.Id())
-	if err != nil {
-		return nil, err
+func (r *scheduleRotationV2Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config scheduleRotationV2Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Period.IsUnknown() {
+		return
 	}
 
-	rotation, err := client.GetRotationByName(ctx, teamID, scheduleName, rotationName)
-	if err != nil {
-		return nil, errors.New("rotation not found")
+	if config.Period.ValueString() == "custom" {
+		if config.CustomPeriodFrequency.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("custom_period_frequency"), "Missing custom_period_frequency", "custom_period_frequency must be set when period is custom")
+		}
+		if config.CustomPeriodUnit.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("custom_period_unit"), "Missing custom_period_unit", "custom_period_unit must be set when period is custom")
+		}
+		return
 	}
-	d.SetId(strconv.Itoa(rotation.NewRotation.ID))
 
-	return []*schema.ResourceData{d}, nil
+	if !config.CustomPeriodFrequency.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("custom_period_frequency"), "Invalid custom_period_frequency", "custom_period_frequency can only be set when period is custom")
+	}
+	if !config.CustomPeriodUnit.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("custom_period_unit"), "Invalid custom_period_unit", "custom_period_unit can only be set when period is custom")
+	}
+	if len(config.ShiftTimeslots) > 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("shift_timeslots"), "Invalid shift_timeslots", "multiple shift_timeslots can only be set when period is custom")
+	}
 }
 
-func resourceScheduleRotationV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*api.Client)
-
-	id := d.Id()
-	tflog.Info(ctx, "Reading rotation", tf.M{
-		"id":   d.Id(),
-		"name": d.Get("name").(string),
-	})
+// ModifyPlan marks end_date as unknown when it is computed by the API and the rotation changes in a way
+// which moves the end date.
+func (r *scheduleRotationV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
 
-	rotation, err := client.GetScheduleRotationById(ctx, id)
-	if err != nil {
-		if api.IsResourceNotFoundError(err) {
-			d.SetId("")
-			return nil
-		}
-		return diag.FromErr(err)
+	var config, plan, state scheduleRotationV2Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !config.EndDate.IsNull() {
+		return
 	}
 
-	if err = tf.EncodeAndSet(rotation, d); err != nil {
-		return diag.FromErr(err)
+	if plan.EndsAfterIterations.Equal(state.EndsAfterIterations) &&
+		plan.StartDate.Equal(state.StartDate) &&
+		plan.Period.Equal(state.Period) &&
+		plan.CustomPeriodFrequency.Equal(state.CustomPeriodFrequency) &&
+		plan.CustomPeriodUnit.Equal(state.CustomPeriodUnit) {
+		return
 	}
 
-	return nil
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("end_date"), types.StringUnknown())...)
 }
 
-func resourceScheduleRotationV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*api.Client)
+func parse3PartImportID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, ":", 3)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of import resource id (%s), expected teamID:ID", id)
+	}
 
-	tflog.Info(ctx, "Creating rotation", tf.M{
-		"name": d.Get("name").(string),
-	})
+	return parts[0], parts[1], parts[2], nil
+}
 
-	createScheduleRotationReq := api.NewRotation{
-		Name:                        d.Get("name").(string),
-		StartDate:                   d.Get("start_date").(string),
-		Period:                      d.Get("period").(string),
-		ChangeParticipantsFrequency: d.Get("change_participants_frequency").(int),
-		ChangeParticipantsUnit:      d.Get("change_participants_unit").(string),
+func (r *scheduleRotationV2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, scheduleName, rotationName, err := parse3PartImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import id", err.Error())
+		return
 	}
 
-	endsAfterIterations, isIterationsEndSet := d.GetOk("ends_after_iterations")
-	endDate, isEndDateSet := d.GetOk("end_date")
-	if isIterationsEndSet {
-		createScheduleRotationReq.EndsAfterIterations = endsAfterIterations.(int)
+	schedules, err := r.client.GetScheduleV2ByName(ctx, teamID, scheduleName)
+	if err != nil {
+		resp.Diagnostics.AddError("schedule not found", err.Error())
+		return
 	}
-	if isEndDateSet {
-		createScheduleRotationReq.EndDate = endDate.(string)
+	if len(schedules.NewSchedule) == 0 {
+		resp.Diagnostics.AddError("schedule not found", fmt.Sprintf("no schedule found with name %s", scheduleName))
+		return
 	}
-	if isIterationsEndSet && isEndDateSet {
-		return diag.Errorf("only one of end_date and ends_after_iterations can be set")
+
+	rotation, err := r.client.GetRotationByName(ctx, teamID, scheduleName, rotationName)
+	if err != nil {
+		resp.Diagnostics.AddError("rotation not found", err.Error())
+		return
 	}
 
-	participants := d.Get("participant_groups").([]interface{})
-	if len(participants) > 0 {
-		var participantGroupsList []api.ParticipantGroup
-		for _, participant := range participants {
-			participantMap, ok := participant.(map[string]interface{})
-			if !ok {
-				return diag.Errorf("participant_groups is invalid")
-			}
-			var participantGroup api.ParticipantGroup
-			var participantsList []api.Participant
-			participants := participantMap["participants"].([]interface{})
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.Itoa(rotation.NewRotation.ID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schedule_id"), int64(schedules.NewSchedule[0].ID))...)
+}
 
-			err := Decode(participants, &participantsList)
+func (r *scheduleRotationV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !readOnlyFrameworkGuard(r.client, "squadcast_schedule_rotation_v2", "create", "", &resp.Diagnostics) {
+		return
+	}
+
+	var plan scheduleRotationV2Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating rotation", tf.M{
+		"name": plan.Name.ValueString(),
+	})
+
+	rotation, err := r.client.CreateScheduleRotation(ctx, int(plan.ScheduleID.ValueInt64()), plan.expand())
+	if err != nil {
+		id, err := adoptExisting(ctx, plan.AdoptExisting.ValueBool(), "rotation", plan.Name.ValueString(), err, func() (string, error) {
+			schedule, err := r.client.GetScheduleV2ById(ctx, strconv.FormatInt(plan.ScheduleID.ValueInt64(), 10))
+			if err != nil {
+				return "", err
+			}
+			rotation, err := r.client.GetRotationByName(ctx, schedule.TeamID, schedule.Name, plan.Name.ValueString())
 			if err != nil {
-				return diag.Errorf(err.Error())
+				return "", err
 			}
-			participantGroup.Participants = participantsList
-			participantGroupsList = append(participantGroupsList, participantGroup)
+			return strconv.Itoa(rotation.NewRotation.ID), nil
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to create the rotation", err.Error())
+			return
 		}
-		createScheduleRotationReq.ParticipantGroups = participantGroupsList
-	}
 
-	shiftTimeSlots := d.Get("shift_timeslots").([]interface{})
-	if len(shiftTimeSlots) > 0 {
-		if createScheduleRotationReq.Period != "custom" && len(shiftTimeSlots) > 1 {
-			return diag.Errorf("multiple shift_timeslots can only be set when period is custom")
-		}
-		var shiftTimeSlotsList []api.Timeslot
-		err := Decode(shiftTimeSlots, &shiftTimeSlotsList)
+		adoptedID, err := strconv.Atoi(id)
 		if err != nil {
-			return diag.Errorf("shift_timeslots is invalid")
+			resp.Diagnostics.AddError("Invalid rotation id", err.Error())
+			return
 		}
-		createScheduleRotationReq.ShiftTimeSlots = shiftTimeSlotsList
-	}
 
-	customPeriodFreq, freqIsSet := d.GetOk("custom_period_frequency")
-	customPeriodUnit, unitIsSet := d.GetOk("custom_period_unit")
+		plan.ID = types.StringValue(id)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
 
-	if createScheduleRotationReq.Period == "custom" {
-		if !freqIsSet || customPeriodFreq.(int) == 0 {
-			return diag.Errorf("custom_period_frequency must be set when period is custom")
+		if _, err := r.client.UpdateScheduleRotation(ctx, adoptedID, plan.expand()); err != nil {
+			resp.Diagnostics.AddError("Unable to update the adopted rotation", err.Error())
+			return
 		}
-		if !unitIsSet || customPeriodUnit.(string) == "" {
-			return diag.Errorf("custom_period_unit must be set when period is custom")
-		}
-
-		createScheduleRotationReq.CustomPeriodFrequency = customPeriodFreq.(int)
-		createScheduleRotationReq.CustomPeriodUnit = customPeriodUnit.(string)
 	} else {
-		if freqIsSet {
-			return diag.Errorf("custom_period_frequency can only be set when period is custom")
-		}
-		if unitIsSet {
-			return diag.Errorf("custom_period_unit can only be set when period is custom")
-		}
+		plan.ID = types.StringValue(strconv.Itoa(rotation.NewRotation.ID))
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
 	}
 
-	rotation, err := client.CreateScheduleRotation(ctx, d.Get("schedule_id").(int), createScheduleRotationReq)
-	if err != nil {
-		return diag.FromErr(err)
+	r.read(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *scheduleRotationV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state scheduleRotationV2Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	d.SetId(strconv.Itoa(rotation.NewRotation.ID))
+	tflog.Info(ctx, "Reading rotation", tf.M{
+		"id":   state.ID.ValueString(),
+		"name": state.Name.ValueString(),
+	})
+
+	found := r.read(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
-	return resourceScheduleRotationV2Read(ctx, d, meta)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func resourceScheduleRotationV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*api.Client)
+func (r *scheduleRotationV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan scheduleRotationV2Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	tflog.Info(ctx, "Creating rotation", tf.M{
-		"name": d.Get("name").(string),
+	if !readOnlyFrameworkGuard(r.client, "squadcast_schedule_rotation_v2", "update", plan.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	tflog.Info(ctx, "Updating rotation", tf.M{
+		"id":   plan.ID.ValueString(),
+		"name": plan.Name.ValueString(),
 	})
-	id, err := strconv.Atoi(d.Id())
+
+	id, err := strconv.Atoi(plan.ID.ValueString())
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError("Invalid rotation id", err.Error())
+		return
 	}
-	updateScheduleRotationReq := api.NewRotation{
-		Name:                        d.Get("name").(string),
-		StartDate:                   d.Get("start_date").(string),
-		Period:                      d.Get("period").(string),
-		ChangeParticipantsFrequency: d.Get("change_participants_frequency").(int),
-		ChangeParticipantsUnit:      d.Get("change_participants_unit").(string),
+
+	if _, err := r.client.UpdateScheduleRotation(ctx, id, plan.expand()); err != nil {
+		resp.Diagnostics.AddError("Unable to update the rotation", err.Error())
+		return
 	}
 
-	endsAfterIterations, isIterationsEndSet := d.GetOk("ends_after_iterations")
-	endDate, isEndDateSet := d.GetOk("end_date")
-	if isIterationsEndSet {
-		updateScheduleRotationReq.EndsAfterIterations = endsAfterIterations.(int)
+	r.read(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if isEndDateSet {
-		updateScheduleRotationReq.EndDate = endDate.(string)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *scheduleRotationV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state scheduleRotationV2Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if isIterationsEndSet && isEndDateSet {
-		return diag.Errorf("only one of end_date and ends_after_iterations can be set")
+
+	if !readOnlyFrameworkGuard(r.client, "squadcast_schedule_rotation_v2", "delete", state.ID.ValueString(), &resp.Diagnostics) {
+		return
 	}
 
-	participants := d.Get("participant_groups").([]interface{})
-	if len(participants) > 0 {
-		var participantGroupsList []api.ParticipantGroup
-		for _, participant := range participants {
-			participantMap, ok := participant.(map[string]interface{})
-			if !ok {
-				return diag.Errorf("participant_groups is invalid")
-			}
-			var participantGroup api.ParticipantGroup
-			var participantsList []api.Participant
-			participants := participantMap["participants"].([]interface{})
+	tflog.Info(ctx, "Deleting rotation", tf.M{
+		"id": state.ID.ValueString(),
+	})
 
-			err := Decode(participants, &participantsList)
-			if err != nil {
-				return diag.Errorf(err.Error())
-			}
-			participantGroup.Participants = participantsList
-			participantGroupsList = append(participantGroupsList, participantGroup)
-		}
-		updateScheduleRotationReq.ParticipantGroups = participantGroupsList
+	if _, err := r.client.DeleteScheduleRotationByID(ctx, state.ID.ValueString()); err != nil && !api.IsResourceNotFoundError(err) {
+		resp.Diagnostics.AddError("Unable to delete the rotation", err.Error())
 	}
+}
 
-	shiftTimeSlots := d.Get("shift_timeslots").([]interface{})
-	if len(shiftTimeSlots) > 0 {
-		if updateScheduleRotationReq.Period != "custom" && len(shiftTimeSlots) > 1 {
-			return diag.Errorf("multiple shift_timeslots can only be set when period is custom")
-		}
-		var shiftTimeSlotsList []api.Timeslot
-		err := Decode(shiftTimeSlots, &shiftTimeSlotsList)
-		if err != nil {
-			return diag.Errorf("shift_timeslots is invalid")
+// read refreshes the model from the API and returns false if the rotation no longer exists.
+func (r *scheduleRotationV2Resource) read(ctx context.Context, m *scheduleRotationV2Model, diags *diag.Diagnostics) bool {
+	rotation, err := r.client.GetScheduleRotationById(ctx, m.ID.ValueString())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return false
 		}
-		updateScheduleRotationReq.ShiftTimeSlots = shiftTimeSlotsList
+		diags.AddError("Unable to read the rotation", err.Error())
+		return false
 	}
 
-	customPeriodFreq, freqIsSet := d.GetOk("custom_period_frequency")
-	customPeriodUnit, unitIsSet := d.GetOk("custom_period_unit")
+	m.flatten(&rotation.NewRotation)
+	return true
+}
 
-	if updateScheduleRotationReq.Period == "custom" {
-		if !freqIsSet || customPeriodFreq.(int) == 0 {
-			return diag.Errorf("custom_period_frequency must be set when period is custom")
-		}
-		if !unitIsSet || customPeriodUnit.(string) == "" {
-			return diag.Errorf("custom_period_unit must be set when period is custom")
-		}
+func (m *scheduleRotationV2Model) expand() api.NewRotation {
+	rotation := api.NewRotation{
+		Name:                        m.Name.ValueString(),
+		StartDate:                   m.StartDate.ValueString(),
+		Period:                      m.Period.ValueString(),
+		CustomPeriodFrequency:       int(m.CustomPeriodFrequency.ValueInt64()),
+		CustomPeriodUnit:            m.CustomPeriodUnit.ValueString(),
+		ChangeParticipantsFrequency: int(m.ChangeParticipantsFrequency.ValueInt64()),
+		ChangeParticipantsUnit:      m.ChangeParticipantsUnit.ValueString(),
+		EndsAfterIterations:         int(m.EndsAfterIterations.ValueInt64()),
+	}
+	if !m.EndDate.IsUnknown() {
+		rotation.EndDate = m.EndDate.ValueString()
+	}
 
-		updateScheduleRotationReq.CustomPeriodFrequency = customPeriodFreq.(int)
-		updateScheduleRotationReq.CustomPeriodUnit = customPeriodUnit.(string)
-	} else {
-		if freqIsSet {
-			return diag.Errorf("custom_period_frequency can only be set when period is custom")
-		}
-		if unitIsSet {
-			return diag.Errorf("custom_period_unit can only be set when period is custom")
+	for _, group := range m.ParticipantGroups {
+		participantGroup := api.ParticipantGroup{Participants: []api.Participant{}}
+		for _, participant := range group.Participants {
+			participantGroup.Participants = append(participantGroup.Participants, api.Participant{
+				ID:   participant.ID.ValueString(),
+				Type: participant.Type.ValueString(),
+			})
 		}
+		rotation.ParticipantGroups = append(rotation.ParticipantGroups, participantGroup)
 	}
 
-	_, err = client.UpdateScheduleRotation(ctx, id, updateScheduleRotationReq)
-	if err != nil {
-		return diag.FromErr(err)
+	for _, slot := range m.ShiftTimeslots {
+		rotation.ShiftTimeSlots = append(rotation.ShiftTimeSlots, api.Timeslot{
+			StartHour:   int(slot.StartHour.ValueInt64()),
+			StartMinute: int(slot.StartMinute.ValueInt64()),
+			Duration:    int(slot.Duration.ValueInt64()),
+			DayOfWeek:   slot.DayOfWeek.ValueString(),
+		})
 	}
 
-	return resourceScheduleRotationV2Read(ctx, d, meta)
+	return rotation
 }
 
-func resourceScheduleRotationV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*api.Client)
-
-	_, err := client.DeleteScheduleRotationByID(ctx, d.Id())
-	if err != nil {
-		tflog.Info(ctx, "No err while deleting rotation")
-		if api.IsResourceNotFoundError(err) {
-			d.SetId("")
-			tflog.Info(ctx, "No resource found while deleting rotation")
-			return nil
+// flatten sets the model from the API response. The API returns zero values for unset optional
+// fields, which are all invalid values for the respective attributes, so they are mapped to null.
+func (m *scheduleRotationV2Model) flatten(rotation *api.NewRotation) {
+	m.ID = types.StringValue(strconv.Itoa(rotation.ID))
+	m.Name = types.StringValue(rotation.Name)
+	m.StartDate = types.StringValue(rotation.StartDate)
+	m.Period = types.StringValue(rotation.Period)
+	m.CustomPeriodFrequency = tf.Int64Value(rotation.CustomPeriodFrequency, types.Int64Null())
+	m.CustomPeriodUnit = tf.StringValue(rotation.CustomPeriodUnit, types.StringNull())
+	m.ChangeParticipantsFrequency = types.Int64Value(int64(rotation.ChangeParticipantsFrequency))
+	m.ChangeParticipantsUnit = types.StringValue(rotation.ChangeParticipantsUnit)
+	m.EndDate = tf.StringValue(rotation.EndDate, types.StringNull())
+	m.EndsAfterIterations = tf.Int64Value(rotation.EndsAfterIterations, types.Int64Null())
+
+	m.ParticipantGroups = make([]participantGroupModel, 0, len(rotation.ParticipantGroups))
+	for _, group := range rotation.ParticipantGroups {
+		participantGroup := participantGroupModel{Participants: make([]participantModel, 0, len(group.Participants))}
+		for _, participant := range group.Participants {
+			participantGroup.Participants = append(participantGroup.Participants, participantModel{
+				Type: types.StringValue(participant.Type),
+				ID:   types.StringValue(participant.ID),
+			})
 		}
-		tflog.Info(ctx, "random err found while deleting rotation")
-		return diag.FromErr(err)
+		m.ParticipantGroups = append(m.ParticipantGroups, participantGroup)
 	}
 
-	tflog.Info(ctx, "No err while deleting rotation")
-	return nil
+	m.ShiftTimeslots = make([]shiftTimeslotModel, 0, len(rotation.ShiftTimeSlots))
+	for _, slot := range rotation.ShiftTimeSlots {
+		m.ShiftTimeslots = append(m.ShiftTimeslots, shiftTimeslotModel{
+			StartHour:   types.Int64Value(int64(slot.StartHour)),
+			StartMinute: types.Int64Value(int64(slot.StartMinute)),
+			Duration:    types.Int64Value(int64(slot.Duration)),
+			DayOfWeek:   tf.StringValue(slot.DayOfWeek, types.StringNull()),
+		})
+	}
 }
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -16,9 +17,9 @@ func TestAccResourceScheduleRotation(t *testing.T) {
 
 	resourceName := "squadcast_schedule_rotation_v2.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckScheduleRotationDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleRotationDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceScheduleRotationConfig(rotationName),
@@ -73,6 +74,25 @@ func TestAccResourceScheduleRotation(t *testing.T) {
 	})
 }
 
+func TestAccResourceScheduleRotationReadOnly(t *testing.T) {
+	rotationName := acctest.RandomWithPrefix("schedule_rotation_v2")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "squadcast" {
+	read_only = true
+}
+` + testAccResourceScheduleRotationConfig(rotationName),
+				ExpectError: regexp.MustCompile(`Refusing to create squadcast_schedule_rotation_v2, read_only is set on the\s+provider`),
+			},
+		},
+	})
+}
+
 func testAccCheckScheduleRotationDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
@@ -16,9 +16,9 @@ func TestAccResourceSchedule(t *testing.T) {
 
 	resourceName := "squadcast_schedule.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckScheduleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceScheduleConfig(scheduleName),
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
@@ -21,9 +22,11 @@ func resourceScheduleV2() *schema.Resource {
 		CreateContext: resourceScheduleV2Create,
 		UpdateContext: resourceScheduleV2Update,
 		DeleteContext: resourceScheduleV2Delete,
+		CustomizeDiff: customdiff.All(customizeDiffDefaultTeamID, customizeDiffTagsAll),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceScheduleV2Import,
 		},
+		Identity: idResourceIdentity(),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -32,9 +35,10 @@ func resourceScheduleV2() *schema.Resource {
 				Computed:    true,
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
@@ -51,9 +55,11 @@ func resourceScheduleV2() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 1000),
 			},
 			"timezone": {
-				Description: "Timezone for the schedule.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:      "Timezone for the schedule, an IANA time zone, e.g. 'Asia/Kolkata'.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     tf.ValidateTimezone,
+				DiffSuppressFunc: tf.SuppressEquivalentTimezone,
 			},
 			"entity_owner": {
 				Description: "Schedule owner.",
@@ -101,11 +107,17 @@ func resourceScheduleV2() *schema.Resource {
 					},
 				},
 			},
+			"tags_all":       tagsAllSchema(),
+			"adopt_existing": adoptExistingSchema("schedule"),
 		},
 	}
 }
 
 func resourceScheduleV2Import(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		return importIdentity(d)
+	}
+
 	client := meta.(*api.Client)
 	teamID, scheduleName, err := parse2PartImportID(d.Id())
 	if err != nil {
@@ -144,10 +156,19 @@ func resourceScheduleV2Read(ctx context.Context, d *schema.ResourceData, meta an
 		return diag.FromErr(err)
 	}
 
+	tags := d.Get("tags")
 	if err = tf.EncodeAndSet(schedule, d); err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err = setTagsAll(d, client, tags); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = setIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -161,11 +182,11 @@ func resourceScheduleV2Create(ctx context.Context, d *schema.ResourceData, meta
 	createScheduleReq := api.NewSchedule{
 		Name:        d.Get("name").(string),
 		Description: d.Get("description").(string),
-		TimeZone:    d.Get("timezone").(string),
+		TimeZone:    tf.CanonicalTimezone(d.Get("timezone").(string)),
 		TeamID:      d.Get("team_id").(string),
 	}
 
-	tags := d.Get("tags").([]interface{})
+	tags := mergeDefaultTagList(client, d.Get("tags").([]interface{}), nil)
 	if len(tags) > 0 {
 		var tagsList []*api.Tag
 		err := Decode(tags, &tagsList)
@@ -189,7 +210,24 @@ func resourceScheduleV2Create(ctx context.Context, d *schema.ResourceData, meta
 
 	schedule, err := client.CreateScheduleV2(ctx, createScheduleReq)
 	if err != nil {
-		return diag.FromErr(err)
+		id, err := adoptExisting(ctx, d.Get("adopt_existing").(bool), "schedule", createScheduleReq.Name, err, func() (string, error) {
+			schedules, err := client.GetScheduleV2ByName(ctx, createScheduleReq.TeamID, createScheduleReq.Name)
+			if err != nil {
+				return "", err
+			}
+			for _, schedule := range schedules.NewSchedule {
+				if schedule.Name == createScheduleReq.Name {
+					return strconv.Itoa(schedule.ID), nil
+				}
+			}
+			return "", errors.New("schedule not found")
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(id)
+		return resourceScheduleV2Update(ctx, d, meta)
 	}
 
 	d.SetId(strconv.Itoa(schedule.NewSchedule.ID))
@@ -210,10 +248,10 @@ func resourceScheduleV2Update(ctx context.Context, d *schema.ResourceData, meta
 	updateScheduleReq := api.UpdateSchedule{
 		Name:        d.Get("name").(string),
 		Description: d.Get("description").(string),
-		TimeZone:    d.Get("timezone").(string),
+		TimeZone:    tf.CanonicalTimezone(d.Get("timezone").(string)),
 	}
 
-	tags := d.Get("tags").([]interface{})
+	tags := mergeDefaultTagList(client, d.Get("tags").([]interface{}), nil)
 	if len(tags) > 0 {
 		var tagsList []*api.Tag
 		err := Decode(tags, &tagsList)
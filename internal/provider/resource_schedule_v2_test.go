@@ -16,9 +16,9 @@ func TestAccResourceScheduleV2(t *testing.T) {
 
 	resourceName := "squadcast_schedule_v2.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckScheduleV2Destroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleV2Destroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceScheduleV2Config(scheduleName),
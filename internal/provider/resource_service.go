@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
@@ -19,9 +20,11 @@ func resourceService() *schema.Resource {
 		ReadContext:   resourceServiceRead,
 		UpdateContext: resourceServiceUpdate,
 		DeleteContext: resourceServiceDelete,
+		CustomizeDiff: customdiff.All(customizeDiffDefaultTeamID, customizeDiffTagsAll),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceServiceImport,
 		},
+		Identity: teamResourceIdentity(),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -42,9 +45,10 @@ func resourceService() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 1000),
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
@@ -63,6 +67,7 @@ func resourceService() *schema.Resource {
 				Description: "Unique API key of this service.",
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 			},
 			"email": {
 				Description: "Email.",
@@ -70,9 +75,10 @@ func resourceService() *schema.Resource {
 				Computed:    true,
 			},
 			"dependencies": {
-				Description: "Dependencies (serviceIds)",
+				Description: "Dependencies (serviceIds). Use `squadcast_service_dependency` instead to manage individual dependencies, e.g. for services that depend on each other.",
 				Type:        schema.TypeSet,
 				Optional:    true,
+				Computed:    true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: tf.ValidateObjectID,
@@ -119,6 +125,7 @@ func resourceService() *schema.Resource {
 					},
 				},
 			},
+			"tags_all": tagsAllSchema(),
 			"alert_sources": {
 				Description: "List of active alert source names. Find all alert sources supported on Squadcast [here](https://www.squadcast.com/integrations).",
 				Type:        schema.TypeList,
@@ -131,6 +138,7 @@ func resourceService() *schema.Resource {
 				Description: "Active alert source endpoints.",
 				Type:        schema.TypeMap,
 				Computed:    true,
+				Sensitive:   true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -139,6 +147,7 @@ func resourceService() *schema.Resource {
 				Description: "All available alert source endpoints.",
 				Type:        schema.TypeMap,
 				Computed:    true,
+				Sensitive:   true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -149,11 +158,16 @@ func resourceService() *schema.Resource {
 				Computed:    true,
 				Optional:    true,
 			},
+			"adopt_existing": adoptExistingSchema("service"),
 		},
 	}
 }
 
 func resourceServiceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		return importIdentity(d, "team_id")
+	}
+
 	teamID, id, err := parse2PartImportID(d.Id())
 	if err != nil {
 		return nil, err
@@ -179,7 +193,7 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta any
 		EmailPrefix:        d.Get("email_prefix").(string),
 	}
 
-	mtags := d.Get("tags").([]any)
+	mtags := mergeDefaultTagList(client, d.Get("tags").([]any), nil)
 
 	if len(mtags) > 0 {
 		var tags []api.ServiceTag
@@ -207,7 +221,19 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta any
 
 	service, err := client.CreateService(ctx, &serviceCreateReq)
 	if err != nil {
-		return diag.FromErr(err)
+		id, err := adoptExisting(ctx, d.Get("adopt_existing").(bool), "service", serviceCreateReq.Name, err, func() (string, error) {
+			service, err := client.GetServiceByName(ctx, serviceCreateReq.TeamID, serviceCreateReq.Name)
+			if err != nil {
+				return "", err
+			}
+			return service.ID, nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(id)
+		return resourceServiceUpdate(ctx, d, meta)
 	}
 
 	d.SetId(service.ID)
@@ -315,10 +341,19 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta any)
 
 	d.Set("alert_sources", alertSourceList)
 
+	tags := d.Get("tags")
 	if err = tf.EncodeAndSet(service, d); err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err = setTagsAll(d, client, tags); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = setIdentity(d, "team_id"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -332,7 +367,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		EmailPrefix:        d.Get("email_prefix").(string),
 	}
 
-	mtags := d.Get("tags").([]any)
+	mtags := mergeDefaultTagList(client, d.Get("tags").([]any), nil)
 
 	if len(mtags) > 0 {
 		var tags []api.ServiceTag
@@ -403,10 +438,12 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta any
 	}
 
 	mdependencies := tf.ExpandStringSet(d.Get("dependencies").(*schema.Set))
-	if len(mdependencies) > 0 {
+	if len(mdependencies) > 0 && d.HasChange("dependencies") {
+		serviceDependencyMutex.Lock(d.Id())
 		_, err = client.UpdateServiceDependencies(ctx, d.Id(), &api.UpdateServiceDependenciesReq{
 			Data: mdependencies,
 		})
+		serviceDependencyMutex.Unlock(d.Id())
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// serviceDependencyMutex serialises dependency updates per service, since the API only
+// accepts the complete list of dependencies of a service.
+var serviceDependencyMutex = tf.NewMutexKV()
+
+func resourceServiceDependency() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single dependency edge between two services. Unlike the `dependencies` attribute of `squadcast_service`, only the given edge is added or removed, so dependencies managed elsewhere are preserved and services can depend on each other without creating a dependency cycle in Terraform. Do not combine this resource with an explicit `dependencies` list on the same service.",
+
+		CreateContext: resourceServiceDependencyCreate,
+		ReadContext:   resourceServiceDependencyRead,
+		DeleteContext: resourceServiceDependencyDelete,
+		CustomizeDiff: customizeDiffDefaultTeamID,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceServiceDependencyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id of the dependent service. Defaults to the `default_team_id` of the provider.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"service_id": {
+				Description:  "Id of the dependent service.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"depends_on_id": {
+				Description:  "Id of the service that `service_id` depends on.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+		},
+	}
+}
+
+func resourceServiceDependencyImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	teamID, serviceID, dependsOnID, err := parse3PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.Set("service_id", serviceID)
+	d.Set("depends_on_id", dependsOnID)
+	d.SetId(fmt.Sprintf("%s:%s", serviceID, dependsOnID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceServiceDependencyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID := d.Get("team_id").(string)
+	serviceID := d.Get("service_id").(string)
+	dependsOnID := d.Get("depends_on_id").(string)
+
+	if serviceID == dependsOnID {
+		return diag.Errorf("a service cannot depend on itself")
+	}
+
+	tflog.Info(ctx, "Adding service dependency", tf.M{
+		"service_id":    serviceID,
+		"depends_on_id": dependsOnID,
+	})
+
+	serviceDependencyMutex.Lock(serviceID)
+	defer serviceDependencyMutex.Unlock(serviceID)
+
+	service, err := client.GetServiceById(ctx, teamID, serviceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !serviceDependsOn(service, dependsOnID) {
+		_, err = client.UpdateServiceDependencies(ctx, serviceID, &api.UpdateServiceDependenciesReq{
+			Data: append(service.Dependencies, dependsOnID),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", serviceID, dependsOnID))
+
+	return resourceServiceDependencyRead(ctx, d, meta)
+}
+
+func resourceServiceDependencyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	serviceID := d.Get("service_id").(string)
+	dependsOnID := d.Get("depends_on_id").(string)
+
+	tflog.Info(ctx, "Reading service dependency", tf.M{
+		"id": d.Id(),
+	})
+	service, err := client.GetServiceById(ctx, d.Get("team_id").(string), serviceID)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if !serviceDependsOn(service, dependsOnID) {
+		tflog.Warn(ctx, "Service dependency no longer exists, removing it from state", tf.M{
+			"id": d.Id(),
+		})
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceServiceDependencyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	serviceID := d.Get("service_id").(string)
+	dependsOnID := d.Get("depends_on_id").(string)
+
+	serviceDependencyMutex.Lock(serviceID)
+	defer serviceDependencyMutex.Unlock(serviceID)
+
+	service, err := client.GetServiceById(ctx, d.Get("team_id").(string), serviceID)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if !serviceDependsOn(service, dependsOnID) {
+		return nil
+	}
+
+	dependencies := make([]string, 0, len(service.Dependencies))
+	for _, id := range service.Dependencies {
+		if id != dependsOnID {
+			dependencies = append(dependencies, id)
+		}
+	}
+
+	_, err = client.UpdateServiceDependencies(ctx, serviceID, &api.UpdateServiceDependenciesReq{
+		Data: dependencies,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func serviceDependsOn(service *api.Service, dependsOnID string) bool {
+	for _, id := range service.Dependencies {
+		if id == dependsOnID {
+			return true
+		}
+	}
+	return false
+}
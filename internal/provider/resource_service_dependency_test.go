@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceServiceDependency(t *testing.T) {
+	serviceName := acctest.RandomWithPrefix("service")
+
+	resourceName := "squadcast_service_dependency.test_a_on_b"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDependencyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceServiceDependencyConfig(serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_id", "squadcast_service.test_a", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "depends_on_id", "squadcast_service.test_b", "id"),
+					resource.TestCheckResourceAttrPair("squadcast_service_dependency.test_b_on_a", "service_id", "squadcast_service.test_b", "id"),
+					resource.TestCheckResourceAttrPair("squadcast_service_dependency.test_b_on_a", "depends_on_id", "squadcast_service.test_a", "id"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: "613611c1eb22db455cfa789f:",
+			},
+		},
+	})
+}
+
+func testAccCheckServiceDependencyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_service_dependency" {
+			continue
+		}
+
+		service, err := client.GetServiceById(context.Background(), rs.Primary.Attributes["team_id"], rs.Primary.Attributes["service_id"])
+		if err != nil {
+			if api.IsResourceNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		if serviceDependsOn(service, rs.Primary.Attributes["depends_on_id"]) {
+			return fmt.Errorf("expected service dependency to be destroyed, %s found", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceServiceDependencyConfig(serviceName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_service" "test_a" {
+	name = "%s-a"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "61361415c2fc70c3101ca7db"
+	email_prefix = "%s-a"
+}
+
+resource "squadcast_service" "test_b" {
+	name = "%s-b"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "61361415c2fc70c3101ca7db"
+	email_prefix = "%s-b"
+}
+
+resource "squadcast_service_dependency" "test_a_on_b" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = squadcast_service.test_a.id
+	depends_on_id = squadcast_service.test_b.id
+}
+
+resource "squadcast_service_dependency" "test_b_on_a" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = squadcast_service.test_b.id
+	depends_on_id = squadcast_service.test_a.id
+}
+	`, serviceName, serviceName, serviceName, serviceName)
+}
@@ -91,38 +91,24 @@ func resourceServiceMaintenanceImport(ctx context.Context, d *schema.ResourceDat
 func resourceServiceMaintenanceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
+	orgID, err := client.OrganizationIDFor(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	var windows []api.ServiceMaintenanceWindow
-	err := Decode(d.Get("windows"), &windows)
+	err = Decode(d.Get("windows"), &windows)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	updateWindows := make([]api.UpdateServiceMaintenanceWindowsWindow, 0, len(windows))
 	for _, w := range windows {
-		uw := api.UpdateServiceMaintenanceWindowsWindow{
-			From:       w.From,
-			Till:       w.Till,
-			RepeatTill: w.RepeatTill,
-		}
-		if w.RepeatFrequency == "" {
-			uw.RepeatTill = uw.Till
-		}
-		if w.RepeatFrequency == "day" {
-			uw.Daily = true
-		} else if w.RepeatFrequency == "week" {
-			uw.Weekly = true
-		} else if w.RepeatFrequency == "2 weeks" {
-			uw.TwoWeekly = true
-		} else if w.RepeatFrequency == "3 weeks" {
-			uw.ThreeWeekly = true
-		} else if w.RepeatFrequency == "month" {
-			uw.Monthly = true
-		}
-		updateWindows = append(updateWindows, uw)
+		updateWindows = append(updateWindows, w.UpdateWindow())
 	}
 
 	_, err = client.UpdateServiceMaintenance(ctx, d.Get("service_id").(string), &api.UpdateServiceMaintenanceWindows{
-		OrganizationID: client.OrganizationID,
+		OrganizationID: orgID,
 		ServiceID:      d.Get("service_id").(string),
 		Data: api.UpdateServiceMaintenanceWindowsData{
 			ServiceMaintenanceWindows: updateWindows,
@@ -173,8 +159,13 @@ func resourceServiceMaintenanceUpdate(ctx context.Context, d *schema.ResourceDat
 func resourceServiceMaintenanceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	_, err := client.UpdateServiceMaintenance(ctx, d.Get("service_id").(string), &api.UpdateServiceMaintenanceWindows{
-		OrganizationID: client.OrganizationID,
+	orgID, err := client.OrganizationIDFor(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateServiceMaintenance(ctx, d.Get("service_id").(string), &api.UpdateServiceMaintenanceWindows{
+		OrganizationID: orgID,
 		ServiceID:      d.Get("service_id").(string),
 		Data: api.UpdateServiceMaintenanceWindowsData{
 			ServiceMaintenanceWindows: []api.UpdateServiceMaintenanceWindowsWindow{},
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceServiceRuleOrdering() *schema.Resource {
+	return &schema.Resource{
+		Description: "The ordering of routing, suppression, tagging or deduplication rules of a service. Rules are evaluated sequentially, starting from the top, so for routing rules the order decides who gets paged. All rules of the given type on the service must be listed.",
+
+		CreateContext: resourceServiceRuleOrderingUpdate,
+		ReadContext:   resourceServiceRuleOrderingRead,
+		UpdateContext: resourceServiceRuleOrderingUpdate,
+		DeleteContext: resourceServiceRuleOrderingDelete,
+		CustomizeDiff: customizeDiffDefaultTeamID,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceServiceRuleOrderingImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"service_id": {
+				Description:  "Service id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"rule_type": {
+				Description:  "Type of the rules to order. Supported values are `routing`, `suppression`, `tagging` and `deduplication`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(api.ServiceRuleTypes, false),
+				ForceNew:     true,
+			},
+			"ordering": {
+				Description: "Rule ids in the order in which they should be evaluated.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceServiceRuleOrderingImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	teamID, serviceID, ruleType, err := parse3PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.Set("service_id", serviceID)
+	d.Set("rule_type", ruleType)
+	d.SetId(fmt.Sprintf("%s:%s", serviceID, ruleType))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceServiceRuleOrderingUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	serviceID := d.Get("service_id").(string)
+	ruleType := d.Get("rule_type").(string)
+	ordering := tf.ListToSlice[string](d.Get("ordering"))
+
+	tflog.Info(ctx, "Updating service rule ordering", tf.M{
+		"service_id": serviceID,
+		"rule_type":  ruleType,
+		"ordering":   ordering,
+	})
+
+	_, err := client.UpdateServiceRulesOrdering(ctx, serviceID, d.Get("team_id").(string), ruleType, ordering)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", serviceID, ruleType))
+
+	return resourceServiceRuleOrderingRead(ctx, d, meta)
+}
+
+func resourceServiceRuleOrderingRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading service rule ordering", tf.M{
+		"id": d.Id(),
+	})
+
+	ordering, err := client.GetServiceRulesOrdering(ctx, d.Get("service_id").(string), d.Get("team_id").(string), d.Get("rule_type").(string))
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(ordering, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// The rules themselves are owned by their own resources, removing the ordering only drops it from the state.
+func resourceServiceRuleOrderingDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
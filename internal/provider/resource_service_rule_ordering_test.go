@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/testdata"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func TestAccResourceServiceRuleOrdering(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("test-team")
+	user := testdata.RandomUser()
+	epName := acctest.RandomWithPrefix("test-ep")
+	serviceName := acctest.RandomWithPrefix("test-service")
+
+	resourceName := "squadcast_service_rule_ordering.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceServiceRuleOrderingConfig(teamName, user, epName, serviceName, "first", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "routing"),
+					resource.TestCheckResourceAttr(resourceName, "ordering.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "ordering.0", "squadcast_routing_rule_v2.first", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "ordering.1", "squadcast_routing_rule_v2.second", "id"),
+				),
+			},
+			{
+				Config: testAccResourceServiceRuleOrderingConfig(teamName, user, epName, serviceName, "second", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ordering.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "ordering.0", "squadcast_routing_rule_v2.second", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "ordering.1", "squadcast_routing_rule_v2.first", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					teamID, err := tf.StateAttr(s, "squadcast_team", "id")
+					if err != nil {
+						return "", err
+					}
+
+					serviceID, err := tf.StateAttr(s, "squadcast_service", "id")
+					if err != nil {
+						return "", err
+					}
+
+					return teamID + ":" + serviceID + ":routing", nil
+				},
+			},
+		},
+	})
+}
+
+func testAccResourceServiceRuleOrderingConfig(teamName string, user testdata.User, epName, serviceName, firstRule, secondRule string) string {
+	return fmt.Sprintf(`
+resource "squadcast_team" "test" {
+	name = "%s"
+}
+
+resource "squadcast_user" "test" {
+	first_name = "%s"
+	last_name = "%s"
+	email = "%s"
+	role = "user"
+}
+
+resource "squadcast_team_member" "test" {
+	team_id = squadcast_team.test.id
+	user_id = squadcast_user.test.id
+	role_ids = [
+		squadcast_team.test.default_role_ids.admin,
+	]
+}
+
+resource "squadcast_escalation_policy" "test" {
+	name = "%s"
+
+	team_id = squadcast_team.test.id
+
+	rules {
+		delay_minutes = 0
+
+		targets {
+			id = squadcast_user.test.id
+			type = "user"
+		}
+	}
+	depends_on = [squadcast_team_member.test]
+}
+
+resource "squadcast_service" "test" {
+	name = "%s"
+	team_id = squadcast_team.test.id
+	escalation_policy_id = squadcast_escalation_policy.test.id
+	email_prefix = "testfoo"
+}
+
+resource "squadcast_routing_rule_v2" "first" {
+	service_id = squadcast_service.test.id
+	is_basic = false
+	expression = "payload[\"event_id\"] == 40"
+
+	route_to_id = squadcast_user.test.id
+	route_to_type = "user"
+}
+
+resource "squadcast_routing_rule_v2" "second" {
+	service_id = squadcast_service.test.id
+	is_basic = false
+	expression = "payload[\"event_id\"] == 41"
+
+	route_to_id = squadcast_escalation_policy.test.id
+	route_to_type = "escalationpolicy"
+}
+
+resource "squadcast_service_rule_ordering" "test" {
+	team_id = squadcast_team.test.id
+	service_id = squadcast_service.test.id
+	rule_type = "routing"
+	ordering = [
+		squadcast_routing_rule_v2.%s.id,
+		squadcast_routing_rule_v2.%s.id,
+	]
+}
+	`, teamName, user.FirstName, user.LastName, user.Email, epName, serviceName, firstRule, secondRule)
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -16,9 +17,9 @@ func TestAccResourceService(t *testing.T) {
 
 	resourceName := "squadcast_service.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceServiceConfig(serviceName),
@@ -78,6 +79,112 @@ func TestAccResourceService(t *testing.T) {
 	})
 }
 
+func TestAccResourceServiceDefaultTeamID(t *testing.T) {
+	serviceName := acctest.RandomWithPrefix("service")
+
+	resourceName := "squadcast_service.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceServiceConfig_defaultTeamID(serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+				),
+			},
+			{
+				Config:   testAccResourceServiceConfig(serviceName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceServiceDefaultTags(t *testing.T) {
+	serviceName := acctest.RandomWithPrefix("service")
+
+	resourceName := "squadcast_service.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceServiceConfig_defaultTags(serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.0.key", "env"),
+					resource.TestCheckResourceAttr(resourceName, "tags.0.value", "staging"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.env", "staging"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.managed_by", "terraform"),
+				),
+			},
+			{
+				Config:   testAccResourceServiceConfig_defaultTags(serviceName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceServiceReadOnly(t *testing.T) {
+	serviceName := acctest.RandomWithPrefix("service")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceServiceConfig_readOnly(serviceName),
+				ExpectError: regexp.MustCompile("Refusing to create squadcast_service, read_only is set on the provider"),
+			},
+		},
+	})
+}
+
+func TestAccResourceServiceAdoptExisting(t *testing.T) {
+	serviceName := acctest.RandomWithPrefix("service")
+
+	var existingID string
+	resourceName := "squadcast_service.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceServiceConfig_existing(serviceName),
+				Check: resource.TestCheckResourceAttrWith("squadcast_service.existing", "id", func(id string) error {
+					existingID = id
+					return nil
+				}),
+			},
+			{
+				Config:      testAccResourceServiceConfig_adoptExisting(serviceName, false),
+				ExpectError: regexp.MustCompile("already exists"),
+			},
+			{
+				Config: testAccResourceServiceConfig_adoptExisting(serviceName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &existingID),
+					resource.TestCheckResourceAttr(resourceName, "name", serviceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "adopted"),
+					resource.TestCheckResourceAttr(resourceName, "email_prefix", "adopted"),
+				),
+			},
+			{
+				Config:   testAccResourceServiceConfig_adoptExisting(serviceName, true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckServiceDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
@@ -112,6 +219,69 @@ resource "squadcast_service" "test" {
 	`, serviceName)
 }
 
+func testAccResourceServiceConfig_defaultTeamID(serviceName string) string {
+	return fmt.Sprintf(`
+provider "squadcast" {
+	default_team_id = "613611c1eb22db455cfa789f"
+}
+
+resource "squadcast_service" "test" {
+	name = "%s"
+	escalation_policy_id = "5f8c4ff09b0ccd917237c04b"
+	email_prefix = "testfoo"
+    alert_sources = ["APImetrics"]
+	slack_channel_id = "C04AQDEPSH3"
+}
+	`, serviceName)
+}
+
+func testAccResourceServiceConfig_defaultTags(serviceName string) string {
+	return fmt.Sprintf(`
+provider "squadcast" {
+	default_tags {
+		tags = {
+			env = "prod"
+			managed_by = "terraform"
+		}
+	}
+}
+
+resource "squadcast_service" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "5f8c4ff09b0ccd917237c04b"
+	email_prefix = "testfoo"
+	maintainer {
+		id = "613611c1eb22db455cfa789f"
+		type = "user"
+	}
+	tags {
+		key = "env"
+		value = "staging"
+	}
+}
+	`, serviceName)
+}
+
+func testAccResourceServiceConfig_readOnly(serviceName string) string {
+	return fmt.Sprintf(`
+provider "squadcast" {
+	read_only = true
+}
+
+resource "squadcast_service" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "5f8c4ff09b0ccd917237c04b"
+	email_prefix = "testfoo"
+	maintainer {
+		id = "613611c1eb22db455cfa789f"
+		type = "user"
+	}
+}
+	`, serviceName)
+}
+
 func testAccResourceServiceConfig_update(serviceName string) string {
 	return fmt.Sprintf(`
 resource "squadcast_service" "test" {
@@ -145,3 +315,42 @@ resource "squadcast_service" "test" {
 }
 	`, serviceName, serviceName, serviceName)
 }
+
+func testAccResourceServiceConfig_existing(serviceName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_service" "existing" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "5f8c4ff09b0ccd917237c04b"
+	email_prefix = "existing"
+	maintainer {
+		id = "613611c1eb22db455cfa789f"
+		type = "user"
+	}
+}
+	`, serviceName)
+}
+
+func testAccResourceServiceConfig_adoptExisting(serviceName string, adoptExisting bool) string {
+	return fmt.Sprintf(`
+removed {
+	from = squadcast_service.existing
+	lifecycle {
+		destroy = false
+	}
+}
+
+resource "squadcast_service" "test" {
+	name = "%s"
+	description = "adopted"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "5f8c4ff09b0ccd917237c04b"
+	email_prefix = "adopted"
+	maintainer {
+		id = "613611c1eb22db455cfa789f"
+		type = "user"
+	}
+	adopt_existing = %t
+}
+	`, serviceName, adoptExisting)
+}
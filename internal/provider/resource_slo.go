@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
@@ -20,6 +21,7 @@ func resourceSlo() *schema.Resource {
 		ReadContext:   resourceSloRead,
 		UpdateContext: resourceSloUpdate,
 		DeleteContext: resourceSloDelete,
+		CustomizeDiff: customdiff.All(customizeDiffDefaultTeamID, customizeDiffTagsAll),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceSloImport,
 		},
@@ -173,9 +175,10 @@ func resourceSlo() *schema.Resource {
 				},
 			},
 			"team_id": {
-				Description:  "The team which SLO resource belongs to",
+				Description:  "The team which SLO resource belongs to. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
@@ -210,6 +213,7 @@ func resourceSlo() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -227,12 +231,17 @@ var alertsMap = map[string]string{"is_breached_err_budget": "breached_error_budg
 func resourceSloImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	client := meta.(*api.Client)
 
+	orgID, err := client.OrganizationIDFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	teamID, id, err := parse2PartImportID(d.Id())
 	if err != nil {
 		return nil, err
 	}
 
-	slo, err := client.GetSlo(ctx, client.OrganizationID, teamID, id)
+	slo, err := client.GetSlo(ctx, orgID, teamID, id)
 	if err != nil {
 		return nil, err
 	}
@@ -249,11 +258,16 @@ func resourceSloImport(ctx context.Context, d *schema.ResourceData, meta any) ([
 
 func resourceSloCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
+
+	orgID, err := client.OrganizationIDFor(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	rules := make([]*api.SloMonitoringCheck, 0)
 	notify := make([]*api.SloNotify, 0)
 	sloActions := make([]*api.SloAction, 0)
 
-	err := Decode(d.Get("rules"), &rules)
+	err = Decode(d.Get("rules"), &rules)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -292,16 +306,12 @@ func resourceSloCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 		createSloReq.SloOwnerType = sloOwner["type"].(string)
 	}
 
-	mtags := d.Get("tags").(map[string]interface{})
-	tags := make(map[string]string, len(*&mtags))
-	if len(mtags) > 0 {
-		for k, v := range *&mtags {
-			tags[k] = v.(string)
-		}
+	tags := mergeDefaultTags(client, tagsToMap(d.Get("tags")))
+	if len(tags) > 0 {
 		createSloReq.Tags = tags
 	}
 
-	slo, err := client.CreateSlo(ctx, client.OrganizationID, ownerID, createSloReq)
+	slo, err := client.CreateSlo(ctx, orgID, ownerID, createSloReq)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -314,6 +324,11 @@ func resourceSloCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 func resourceSloRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
+	orgID, err := client.OrganizationIDFor(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	sloID, ok := d.GetOk("id")
 	if !ok {
 		return diag.Errorf("invalid slo id")
@@ -329,7 +344,7 @@ func resourceSloRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 		"team_id": d.Get("team_id").(string),
 	})
 
-	slo, err := client.GetSlo(ctx, client.OrganizationID, teamID.(string), sloID.(string))
+	slo, err := client.GetSlo(ctx, orgID, teamID.(string), sloID.(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -338,20 +353,30 @@ func resourceSloRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 		alert.Name = alertsMap[alert.Name]
 	}
 
+	tags := d.Get("tags")
 	if err = tf.EncodeAndSet(slo, d); err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err = setTagsAll(d, client, tags); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
 func resourceSloUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
+
+	orgID, err := client.OrganizationIDFor(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	var rules []*api.SloMonitoringCheck
 	sloActions := make([]*api.SloAction, 0)
 	notify := make([]*api.SloNotify, 0)
 
-	err := Decode(d.Get("rules"), &rules)
+	err = Decode(d.Get("rules"), &rules)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -395,16 +420,12 @@ func resourceSloUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 		SloOwnerID:          sloOwner["id"].(string),
 	}
 
-	mtags := d.Get("tags").(map[string]interface{})
-	tags := make(map[string]string, len(*&mtags))
-	if len(mtags) > 0 {
-		for k, v := range *&mtags {
-			tags[k] = v.(string)
-		}
+	tags := mergeDefaultTags(client, tagsToMap(d.Get("tags")))
+	if len(tags) > 0 {
 		updateSloReq.Tags = tags
 	}
 
-	_, err = client.UpdateSlo(ctx, client.OrganizationID, ownerID, id, updateSloReq)
+	_, err = client.UpdateSlo(ctx, orgID, ownerID, id, updateSloReq)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -415,6 +436,11 @@ func resourceSloUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 func resourceSloDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
+	orgID, err := client.OrganizationIDFor(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	tflog.Info(ctx, "Deleting Slos", map[string]interface{}{
 		"name": d.Get("name").(string),
 	})
@@ -424,7 +450,7 @@ func resourceSloDelete(ctx context.Context, d *schema.ResourceData, meta any) di
 		return diag.Errorf("invalid team id")
 	}
 
-	_, err := client.DeleteSlo(ctx, client.OrganizationID, teamID.(string), d.Id())
+	_, err = client.DeleteSlo(ctx, orgID, teamID.(string), d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
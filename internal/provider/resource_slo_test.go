@@ -16,9 +16,9 @@ func TestAccResourceSlo(t *testing.T) {
 
 	resourceName := "squadcast_slo.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckSloDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSloDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceSloConfig(sloName),
@@ -21,9 +21,11 @@ func resourceSquad() *schema.Resource {
 		ReadContext:   resourceSquadRead,
 		UpdateContext: resourceSquadUpdate,
 		DeleteContext: resourceSquadDelete,
+		CustomizeDiff: customizeDiffDefaultTeamID,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceSquadImport,
 		},
+		Identity: teamResourceIdentity(),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -38,9 +40,10 @@ func resourceSquad() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 1000),
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
@@ -89,6 +92,10 @@ func parse2PartImportID(id string) (string, string, error) {
 }
 
 func resourceSquadImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		return importIdentity(d, "team_id")
+	}
+
 	teamID, id, err := parse2PartImportID(d.Id())
 	if err != nil {
 		return nil, err
@@ -174,6 +181,10 @@ func resourceSquadRead(ctx context.Context, d *schema.ResourceData, meta any) di
 		return diag.FromErr(err)
 	}
 
+	if err = setIdentity(d, "team_id"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -16,9 +16,9 @@ func TestAccResourceSquad(t *testing.T) {
 
 	resourceName := "squadcast_squad.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckSquadDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSquadDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceSquadConfig(squadName),
@@ -20,9 +20,11 @@ func resourceStatusPage() *schema.Resource {
 		ReadContext:   resourceStatusPageRead,
 		UpdateContext: resourceStatusPageUpdate,
 		DeleteContext: resourceStatusPageDelete,
+		CustomizeDiff: customizeDiffDefaultTeamID,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceStatusPageImport,
 		},
+		Identity: idResourceIdentity(),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -31,9 +33,10 @@ func resourceStatusPage() *schema.Resource {
 				Computed:    true,
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 			},
 			"name": {
@@ -63,9 +66,11 @@ func resourceStatusPage() *schema.Resource {
 				Optional:    true,
 			},
 			"timezone": {
-				Description: "Timezone for the status page.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:      "Timezone for the status page, an IANA time zone, e.g. 'Asia/Kolkata'.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     tf.ValidateTimezone,
+				DiffSuppressFunc: tf.SuppressEquivalentTimezone,
 			},
 			"contact_email": {
 				Description: "Contact email.",
@@ -143,6 +148,10 @@ func resourceStatusPage() *schema.Resource {
 }
 
 func resourceStatusPageImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		return importIdentity(d)
+	}
+
 	client := meta.(*api.Client)
 
 	sp, err := client.GetStatusPageById(ctx, d.Id())
@@ -165,7 +174,7 @@ func resourceStatusPageCreate(ctx context.Context, d *schema.ResourceData, meta
 		Description:                  d.Get("description").(string),
 		IsPublic:                     d.Get("is_public").(bool),
 		DomainName:                   d.Get("domain_name").(string),
-		Timezone:                     d.Get("timezone").(string),
+		Timezone:                     tf.CanonicalTimezone(d.Get("timezone").(string)),
 		ContactEmail:                 d.Get("contact_email").(string),
 		AllowWebhookSubscription:     d.Get("allow_webhook_subscription").(bool),
 		AllowMaintenanceSubscription: d.Get("allow_maintenance_subscription").(bool),
@@ -226,6 +235,10 @@ func resourceStatusPageRead(ctx context.Context, d *schema.ResourceData, meta an
 		return diag.FromErr(err)
 	}
 
+	if err = setIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -245,7 +258,7 @@ func resourceStatusPageUpdate(ctx context.Context, d *schema.ResourceData, meta
 		Name:                         d.Get("name").(string),
 		Description:                  d.Get("description").(string),
 		IsPublic:                     d.Get("is_public").(bool),
-		Timezone:                     d.Get("timezone").(string),
+		Timezone:                     tf.CanonicalTimezone(d.Get("timezone").(string)),
 		ContactEmail:                 d.Get("contact_email").(string),
 		AllowWebhookSubscription:     d.Get("allow_webhook_subscription").(bool),
 		AllowMaintenanceSubscription: d.Get("allow_maintenance_subscription").(bool),
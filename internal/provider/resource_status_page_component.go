@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -51,6 +52,22 @@ func resourceStatusPageComponent() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"service_ids": {
+				Description:  "Ids of the services linked to this component. Incidents on these services update the status of the component automatically. The services must belong to the team of the status page.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				RequiredWith: []string{"impact_status_id"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: tf.ValidateObjectID,
+				},
+			},
+			"impact_status_id": {
+				Description:  "Id of the impact level the component is set to while a linked service has an open incident, e.g. from the `impacts` of a `squadcast_status_page_statuses`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"service_ids"},
+			},
 		},
 	}
 }
@@ -66,12 +83,47 @@ func resourceStatusPageComponentImport(ctx context.Context, d *schema.ResourceDa
 	return []*schema.ResourceData{d}, nil
 }
 
+// validateStatusPageComponentServices checks that the services linked to a component belong to the
+// team of its status page.
+func validateStatusPageComponentServices(ctx context.Context, client *api.Client, pageID string, serviceIDs []string) error {
+	if len(serviceIDs) == 0 {
+		return nil
+	}
+
+	statusPage, err := client.GetStatusPageById(ctx, pageID)
+	if err != nil {
+		return err
+	}
+	services, err := client.ListServices(ctx, statusPage.TeamID)
+	if err != nil {
+		return err
+	}
+
+	teamServices := make(map[string]bool, len(services))
+	for _, service := range services {
+		teamServices[service.ID] = true
+	}
+	for _, serviceID := range serviceIDs {
+		if !teamServices[serviceID] {
+			return fmt.Errorf("service %s does not belong to the team %s of the status page %s", serviceID, statusPage.TeamID, pageID)
+		}
+	}
+
+	return nil
+}
+
 func resourceStatusPageComponentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
 	createStatusPageComponentReq := &api.StatusPageComponent{
-		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		ServiceIDs:     tf.ExpandStringSet(d.Get("service_ids").(*schema.Set)),
+		ImpactStatusID: uint(d.Get("impact_status_id").(int)),
+	}
+
+	if err := validateStatusPageComponentServices(ctx, client, d.Get("status_page_id").(string), createStatusPageComponentReq.ServiceIDs); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if d.Get("group_id").(string) != "" {
@@ -122,8 +174,14 @@ func resourceStatusPageComponentUpdate(ctx context.Context, d *schema.ResourceDa
 	client := meta.(*api.Client)
 
 	updateStatusPageReq := &api.StatusPageComponent{
-		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		ServiceIDs:     tf.ExpandStringSet(d.Get("service_ids").(*schema.Set)),
+		ImpactStatusID: uint(d.Get("impact_status_id").(int)),
+	}
+
+	if err := validateStatusPageComponentServices(ctx, client, d.Get("status_page_id").(string), updateStatusPageReq.ServiceIDs); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if d.Get("group_id").(string) == "" {
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -16,9 +17,9 @@ func TestAccResourceStatusPageComponent(t *testing.T) {
 
 	resourceName := "squadcast_status_page_component.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckStatusPageComponentDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStatusPageComponentDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceStatusPageComponentConfig(statusPageComponentName),
@@ -48,6 +49,36 @@ func TestAccResourceStatusPageComponent(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "group_id", "200"),
 				),
 			},
+			{
+				Config: testAccResourceStatusPageComponentConfig_services(statusPageComponentName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "status_page_id", "100"),
+					resource.TestCheckResourceAttr(resourceName, "service_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "service_ids.*", "squadcast_service.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "impact_status_id", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceStatusPageComponentServicesTeam(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "squadcast_status_page_component" "test" {
+	status_page_id = "100"
+	name = "component"
+	service_ids = ["5f8891527f735f0a6646f3b6"]
+	impact_status_id = 3
+}
+				`,
+				ExpectError: regexp.MustCompile("service 5f8891527f735f0a6646f3b6 does not belong to the team"),
+			},
 		},
 	})
 }
@@ -103,3 +134,26 @@ resource "squadcast_status_page_component" "test" {
 }
 	`, statusPageComponentName)
 }
+
+func testAccResourceStatusPageComponentConfig_services(statusPageComponentName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_service" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "5f8c4ff09b0ccd917237c04b"
+	email_prefix = "%s"
+	maintainer {
+		id = "613611c1eb22db455cfa789f"
+		type = "user"
+	}
+}
+
+resource "squadcast_status_page_component" "test" {
+	status_page_id = "100"
+	name = "%s"
+	description = "Updated Sample status page component description."
+	service_ids = [squadcast_service.test.id]
+	impact_status_id = 3
+}
+	`, statusPageComponentName, statusPageComponentName, statusPageComponentName)
+}
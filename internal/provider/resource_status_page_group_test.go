@@ -16,9 +16,9 @@ func TestAccResourceStatusPageGroup(t *testing.T) {
 
 	resourceName := "squadcast_status_page_group.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckStatusPageGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStatusPageGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceStatusPageGroupConfig(statusPageGroupName),
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceStatusPageIssueStatus() *schema.Resource {
+	return &schema.Resource{
+		Description: "Status page issue status is a custom status which the issues of a status page can be set to, in addition to the statuses provided by Squadcast.",
+
+		CreateContext: resourceStatusPageIssueStatusCreate,
+		ReadContext:   resourceStatusPageIssueStatusRead,
+		UpdateContext: resourceStatusPageIssueStatusUpdate,
+		DeleteContext: resourceStatusPageIssueStatusDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStatusPageIssueStatusImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Issue status id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status_page_id": {
+				Description: "Id of the status page to which this issue status belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description:  "Name of the issue status.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"color": {
+				Description:  "Color of the issue status, as a hex code, e.g. '#f2994a'.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^#[0-9a-fA-F]{6}$`), "must be a hex color code, e.g. '#f2994a'"),
+			},
+		},
+	}
+}
+
+func resourceStatusPageIssueStatusImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	pageID, statusID, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("status_page_id", pageID)
+	d.SetId(statusID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceStatusPageIssueStatusCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req := &api.StatusPageStatus{
+		Name:  d.Get("name").(string),
+		Color: d.Get("color").(string),
+	}
+
+	tflog.Info(ctx, "Creating statuspage issue status", tf.M{
+		"name": req.Name,
+	})
+	status, err := client.CreateStatusPageStatus(ctx, d.Get("status_page_id").(string), req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatUint(uint64(status.ID), 10))
+
+	return resourceStatusPageIssueStatusRead(ctx, d, meta)
+}
+
+func resourceStatusPageIssueStatusRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading statuspage issue status", tf.M{
+		"id": d.Id(),
+	})
+	status, err := client.GetStatusPageStatusById(ctx, d.Get("status_page_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(status, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceStatusPageIssueStatusUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req := &api.StatusPageStatus{
+		Name:  d.Get("name").(string),
+		Color: d.Get("color").(string),
+	}
+
+	tflog.Info(ctx, "Updating statuspage issue status", tf.M{
+		"id":   d.Id(),
+		"name": req.Name,
+	})
+	if _, err := client.UpdateStatusPageStatus(ctx, d.Get("status_page_id").(string), d.Id(), req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceStatusPageIssueStatusRead(ctx, d, meta)
+}
+
+func resourceStatusPageIssueStatusDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Deleting statuspage issue status", tf.M{
+		"id": d.Id(),
+	})
+	_, err := client.DeleteStatusPageStatus(ctx, d.Get("status_page_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
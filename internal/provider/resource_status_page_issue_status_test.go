@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceStatusPageIssueStatus(t *testing.T) {
+	issueStatusName := acctest.RandomWithPrefix("issueStatus")
+
+	resourceName := "squadcast_status_page_issue_status.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStatusPageIssueStatusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceStatusPageIssueStatusConfig(issueStatusName, "#f2994a"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "status_page_id", "100"),
+					resource.TestCheckResourceAttr(resourceName, "name", issueStatusName),
+					resource.TestCheckResourceAttr(resourceName, "color", "#f2994a"),
+				),
+			},
+			{
+				Config: testAccResourceStatusPageIssueStatusConfig(issueStatusName, "#27ae60"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "status_page_id", "100"),
+					resource.TestCheckResourceAttr(resourceName, "name", issueStatusName),
+					resource.TestCheckResourceAttr(resourceName, "color", "#27ae60"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: "100:",
+			},
+		},
+	})
+}
+
+func testAccCheckStatusPageIssueStatusDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_status_page_issue_status" {
+			continue
+		}
+
+		_, err := client.GetStatusPageStatusById(context.Background(), rs.Primary.Attributes["status_page_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected status page issue status to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceStatusPageIssueStatusConfig(issueStatusName, color string) string {
+	return fmt.Sprintf(`
+resource "squadcast_status_page_issue_status" "test" {
+	status_page_id = "100"
+	name = "%s"
+	color = "%s"
+}
+	`, issueStatusName, color)
+}
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceStatusPageMaintenance() *schema.Resource {
+	return &schema.Resource{
+		Description: "Status page maintenance is a scheduled maintenance published on a status page, with the components it affects.",
+
+		CreateContext: resourceStatusPageMaintenanceCreate,
+		ReadContext:   resourceStatusPageMaintenanceRead,
+		UpdateContext: resourceStatusPageMaintenanceUpdate,
+		DeleteContext: resourceStatusPageMaintenanceDelete,
+		CustomizeDiff: customizeDiffStatusPageMaintenanceTime,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStatusPageMaintenanceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Maintenance id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status_page_id": {
+				Description: "Id of the status page on which this maintenance is published.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"title": {
+				Description:  "Title of the maintenance.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Description: "Description of the maintenance.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"start_time": {
+				Description:      "Start time of the maintenance, in RFC3339 format.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+			"end_time": {
+				Description:      "End time of the maintenance, in RFC3339 format.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+			"components": {
+				Description: "Components affected by the maintenance.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_id": {
+							Description: "Id of the status page component.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"impact_status_id": {
+							Description: "Id of the impact level of the component during the maintenance, e.g. from the `impacts` of a `squadcast_status_page_statuses`.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"notify_subscribers": {
+				Description: "Notify the subscribers of the status page when the maintenance is created or updated. Changing only this flag does not update the maintenance, so subscribers are not notified again. It is set to false on import. Defaults to false.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+// customizeDiffStatusPageMaintenanceTime checks that the maintenance ends after it starts.
+func customizeDiffStatusPageMaintenanceTime(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("start_time") || !d.NewValueKnown("end_time") {
+		return nil
+	}
+
+	startTime, err := time.Parse(time.RFC3339, d.Get("start_time").(string))
+	if err != nil {
+		return nil
+	}
+	endTime, err := time.Parse(time.RFC3339, d.Get("end_time").(string))
+	if err != nil {
+		return nil
+	}
+	if !endTime.After(startTime) {
+		return errors.New("end_time must be after start_time")
+	}
+
+	return nil
+}
+
+func resourceStatusPageMaintenanceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	pageID, maintenanceID, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("status_page_id", pageID)
+	// The flag is only sent with changes, the API does not return it.
+	d.Set("notify_subscribers", false)
+	d.SetId(maintenanceID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandStatusPageMaintenance(d *schema.ResourceData) (*api.StatusPageMaintenance, error) {
+	req := &api.StatusPageMaintenance{
+		Title:             d.Get("title").(string),
+		Description:       d.Get("description").(string),
+		StartTime:         d.Get("start_time").(string),
+		EndTime:           d.Get("end_time").(string),
+		NotifySubscribers: d.Get("notify_subscribers").(bool),
+		Components:        []*api.StatusPageMaintenanceComponent{},
+	}
+
+	for _, v := range d.Get("components").([]any) {
+		mcomponent := v.(map[string]any)
+		componentID, err := strconv.ParseUint(mcomponent["component_id"].(string), 10, 64)
+		if err != nil {
+			return nil, errors.New("component_id must be the numeric id of a status page component")
+		}
+		req.Components = append(req.Components, &api.StatusPageMaintenanceComponent{
+			ComponentID:    uint(componentID),
+			ImpactStatusID: uint(mcomponent["impact_status_id"].(int)),
+		})
+	}
+
+	return req, nil
+}
+
+func resourceStatusPageMaintenanceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := expandStatusPageMaintenance(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating statuspage maintenance", tf.M{
+		"title": req.Title,
+	})
+	maintenance, err := client.CreateStatusPageMaintenance(ctx, d.Get("status_page_id").(string), req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatUint(uint64(maintenance.ID), 10))
+
+	return resourceStatusPageMaintenanceRead(ctx, d, meta)
+}
+
+func resourceStatusPageMaintenanceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading statuspage maintenance", tf.M{
+		"id": d.Id(),
+	})
+	maintenance, err := client.GetStatusPageMaintenanceById(ctx, d.Get("status_page_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(maintenance, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceStatusPageMaintenanceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	// Only the flag changed, updating the maintenance would notify the subscribers again.
+	if !d.HasChangeExcept("notify_subscribers") {
+		return resourceStatusPageMaintenanceRead(ctx, d, meta)
+	}
+
+	req, err := expandStatusPageMaintenance(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Updating statuspage maintenance", tf.M{
+		"id":    d.Id(),
+		"title": req.Title,
+	})
+	if _, err = client.UpdateStatusPageMaintenance(ctx, d.Get("status_page_id").(string), d.Id(), req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceStatusPageMaintenanceRead(ctx, d, meta)
+}
+
+func resourceStatusPageMaintenanceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Deleting statuspage maintenance", tf.M{
+		"id": d.Id(),
+	})
+	_, err := client.DeleteStatusPageMaintenance(ctx, d.Get("status_page_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceStatusPageMaintenance(t *testing.T) {
+	maintenanceTitle := acctest.RandomWithPrefix("maintenance")
+
+	resourceName := "squadcast_status_page_maintenance.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStatusPageMaintenanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceStatusPageMaintenanceConfig(maintenanceTitle),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "status_page_id", "100"),
+					resource.TestCheckResourceAttr(resourceName, "title", maintenanceTitle),
+					resource.TestCheckResourceAttr(resourceName, "start_time", "2032-01-10T02:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "end_time", "2032-01-10T04:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "components.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "notify_subscribers", "false"),
+				),
+			},
+			{
+				Config: testAccResourceStatusPageMaintenanceConfig_update(maintenanceTitle, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "status_page_id", "100"),
+					resource.TestCheckResourceAttr(resourceName, "title", maintenanceTitle),
+					resource.TestCheckResourceAttr(resourceName, "description", "Database upgrade."),
+					resource.TestCheckResourceAttr(resourceName, "components.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "components.0.component_id", "200"),
+					resource.TestCheckResourceAttr(resourceName, "components.0.impact_status_id", "3"),
+					resource.TestCheckResourceAttr(resourceName, "notify_subscribers", "true"),
+				),
+			},
+			{
+				Config: testAccResourceStatusPageMaintenanceConfig_update(maintenanceTitle, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "Database upgrade."),
+					resource.TestCheckResourceAttr(resourceName, "notify_subscribers", "false"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: "100:",
+			},
+		},
+	})
+}
+
+func TestAccResourceStatusPageMaintenanceTime(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "squadcast_status_page_maintenance" "test" {
+	status_page_id = "100"
+	title = "maintenance"
+	start_time = "2032-01-10T04:00:00Z"
+	end_time = "2032-01-10T02:00:00Z"
+}
+				`,
+				ExpectError: regexp.MustCompile("end_time must be after start_time"),
+			},
+		},
+	})
+}
+
+func testAccCheckStatusPageMaintenanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_status_page_maintenance" {
+			continue
+		}
+
+		_, err := client.GetStatusPageMaintenanceById(context.Background(), rs.Primary.Attributes["status_page_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected status page maintenance to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceStatusPageMaintenanceConfig(maintenanceTitle string) string {
+	return fmt.Sprintf(`
+resource "squadcast_status_page_maintenance" "test" {
+	status_page_id = "100"
+	title = "%s"
+	start_time = "2032-01-10T02:00:00Z"
+	end_time = "2032-01-10T04:00:00Z"
+}
+	`, maintenanceTitle)
+}
+
+func testAccResourceStatusPageMaintenanceConfig_update(maintenanceTitle string, notifySubscribers bool) string {
+	return fmt.Sprintf(`
+resource "squadcast_status_page_maintenance" "test" {
+	status_page_id = "100"
+	title = "%s"
+	description = "Database upgrade."
+	start_time = "2032-01-10T02:00:00Z"
+	end_time = "2032-01-10T04:00:00Z"
+	notify_subscribers = %t
+
+	components {
+		component_id = "200"
+		impact_status_id = 3
+	}
+}
+	`, maintenanceTitle, notifySubscribers)
+}
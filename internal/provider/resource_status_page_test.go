@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -16,9 +18,9 @@ func TestAccResourceStatusPage(t *testing.T) {
 
 	resourceName := "squadcast_status_page.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckStatusPageDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStatusPageDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceStatusPageConfig(statusPageName),
@@ -64,6 +66,29 @@ func TestAccResourceStatusPage(t *testing.T) {
 	})
 }
 
+func TestAccResourceStatusPageTimezone(t *testing.T) {
+	statusPageName := acctest.RandomWithPrefix("statuspage")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStatusPageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      strings.Replace(testAccResourceStatusPageConfig(statusPageName), "Asia/Kolkata", "Asia/Kolkatta", 1),
+				ExpectError: regexp.MustCompile(`"Asia/Kolkatta" is not a valid IANA time zone, did you mean "Asia/Kolkata"\?`),
+			},
+			{
+				Config: testAccResourceStatusPageConfig(statusPageName),
+			},
+			{
+				Config:   strings.Replace(testAccResourceStatusPageConfig(statusPageName), "Asia/Kolkata", "asia/kolkata", 1),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckStatusPageDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
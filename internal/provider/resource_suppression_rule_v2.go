@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -65,38 +66,57 @@ func resourceSuppressionRuleV2() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"time_zone": {
-							Description: "Time zone for the time slot",
-							Type:        schema.TypeString,
-							Required:    true,
-							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-								_, err := time.LoadLocation(val.(string))
-								if err != nil {
-									errs = append(errs, err)
-								}
-								return
-							},
+							Description:      "Time zone for the time slot",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     tf.ValidateTimezone,
+							DiffSuppressFunc: tf.SuppressEquivalentTimezone,
 						},
 						"start_time": {
-							Description: "Defines the start date of the time slot",
+							Description: "Defines the start date of the time slot. Required unless `rrule` is set.",
 							Type:        schema.TypeString,
-							Required:    true,
+							Optional:    true,
+							Computed:    true,
 						},
 						"end_time": {
-							Description: "Defines the end date of the time slot",
+							Description: "Defines the end date of the time slot. Required unless `rrule` is set.",
 							Type:        schema.TypeString,
-							Required:    true,
+							Optional:    true,
+							Computed:    true,
 						},
 						"ends_on": {
-							Description: "Defines the end date of the repetition",
+							Description: "Defines the end date of the repetition. Required unless `rrule` is set.",
 							Type:        schema.TypeString,
-							Required:    true,
+							Optional:    true,
+							Computed:    true,
 						},
 						"repetition": {
-							Description:  "Defines the repetition of the time slot",
+							Description:  "Defines the repetition of the time slot. Required unless `rrule` is set.",
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
+							Computed:     true,
 							ValidateFunc: validation.StringInSlice([]string{"none", "daily", "weekly", "monthly", "custom"}, false),
 						},
+						"rrule": {
+							Description:      "An [RFC 5545](https://datatracker.ietf.org/doc/html/rfc5545#section-3.3.10) recurrence rule, e.g. `FREQ=WEEKLY;BYDAY=SA,SU`, as an alternative to `start_time`, `end_time`, `ends_on`, `repetition`, `ends_never` and `custom`. Supported parts are `FREQ` (`DAILY`, `WEEKLY` or `MONTHLY`), `INTERVAL`, `BYDAY` (weekly only), `UNTIL` and `COUNT=1` for a single occurrence. Requires `dtstart` and `duration`.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validateRRule,
+							DiffSuppressFunc: suppressEquivalentRRule,
+						},
+						"dtstart": {
+							Description:      "Start of the first occurrence as an RFC 3339 timestamp. Used with `rrule`.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: suppressEquivalentRFC3339Time,
+						},
+						"duration": {
+							Description:      "Duration of each occurrence, e.g. `2h` or `24h`. Used with `rrule`.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressEquivalentDuration,
+						},
 						"is_allday": {
 							Description: "Defines if the time slot is an all day slot",
 							Type:        schema.TypeBool,
@@ -106,6 +126,7 @@ func resourceSuppressionRuleV2() *schema.Resource {
 							Description: "Defines whether the time slot ends or not",
 							Type:        schema.TypeBool,
 							Optional:    true,
+							Computed:    true,
 						},
 						"is_custom": {
 							Description: "Defines whether repetition is custom or not",
@@ -116,6 +137,7 @@ func resourceSuppressionRuleV2() *schema.Resource {
 							Description: "Use this field to specify the custom time slots for which this rule should be applied. This field is only applicable when the repetition field is set to custom.",
 							Type:        schema.TypeList,
 							Optional:    true,
+							Computed:    true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"repeats": {
@@ -255,7 +277,28 @@ func resourceSuppressionRuleReadV2(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	if err = tf.EncodeAndSet(suppressionRule, d); err != nil {
+	m, err := suppressionRule.Encode()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// time slots configured through rrule are read back in the same form
+	mtimeSlots, _ := m["timeslots"].([]any)
+	for i, t := range suppressionRule.Rule.TimeSlots {
+		if d.Get(fmt.Sprintf("timeslots.%d.rrule", i)).(string) == "" || i >= len(mtimeSlots) {
+			continue
+		}
+		rrule, dtstart, duration, err := t.RRule()
+		if err != nil {
+			return diag.Errorf("timeslots.%d cannot be expressed as an rrule: %s", i, err)
+		}
+		mtimeSlot := mtimeSlots[i].(map[string]any)
+		mtimeSlot["rrule"] = rrule
+		mtimeSlot["dtstart"] = dtstart
+		mtimeSlot["duration"] = duration
+	}
+
+	if err = tf.SetState(d, m); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -342,53 +385,115 @@ func decodeSuppressionRulesTimeslots(mtimeSlots []interface{}) ([]*api.TimeSlot,
 	if len(mtimeSlots) == 0 {
 		return nil, false, nil
 	}
-	for _, mtimeSlot := range mtimeSlots {
+	timeslots := make([]*api.TimeSlot, 0, len(mtimeSlots))
+	for i, mtimeSlot := range mtimeSlots {
 		mtimeSlot := mtimeSlot.(map[string]interface{})
-		if mtimeSlot["repetition"] != "custom" { // if repetition is not custom, skip
-			mtimeSlot["custom"] = nil
+
+		if rrule, _ := mtimeSlot["rrule"].(string); rrule != "" {
+			timeslot, err := api.TimeSlotFromRRule(tf.CanonicalTimezone(mtimeSlot["time_zone"].(string)), rrule, mtimeSlot["dtstart"].(string), mtimeSlot["duration"].(string), mtimeSlot["is_allday"].(bool))
+			if err != nil {
+				return nil, false, diag.Errorf("timeslots.%d: %s", i, err)
+			}
+			timeslots = append(timeslots, timeslot)
 			continue
 		}
 
-		if len(mtimeSlot["custom"].([]interface{})) == 0 {
-			return nil, false, diag.Errorf("timeslots.custom cannot be empty when timeslots.repetition is set to 'custom'")
+		for _, key := range []string{"start_time", "end_time", "ends_on", "repetition"} {
+			if mtimeSlot[key].(string) == "" {
+				return nil, false, diag.Errorf("timeslots.%d.%s is required when timeslots.%d.rrule is not set", i, key, i)
+			}
 		}
-		mcustom := mtimeSlot["custom"].([]interface{})[0].(map[string]interface{})
-		mrepeats := mcustom["repeats"].(string)
-		mrepeatOnWeekdays := mcustom["repeats_on_weekdays"].([]interface{})
-		repeatOnWeekdays := make([]int, len(mrepeatOnWeekdays))
-		repeatsOnMonth := ""
-
-		// ? VALIDATION:
-		// if repeats is week, set repeats_on_weekdays to the value from tfstate
-		// if repeats is not week, set repeats_on_weekdays to nil
-		// if repeats is month, set repeats_on_month to date-occurrence
-
-		switch mrepeats {
-		case "week":
-			for i, v := range mrepeatOnWeekdays {
-				repeatOnWeekdays[i] = v.(int)
+
+		mtimeSlot["time_zone"] = tf.CanonicalTimezone(mtimeSlot["time_zone"].(string))
+		if mtimeSlot["repetition"] != "custom" { // if repetition is not custom, skip
+			mtimeSlot["custom"] = nil
+		} else {
+			if len(mtimeSlot["custom"].([]interface{})) == 0 {
+				return nil, false, diag.Errorf("timeslots.custom cannot be empty when timeslots.repetition is set to 'custom'")
 			}
-		case "month":
-			repeatsOnMonth = "date-occurrence"
-		default:
-			if len(mrepeatOnWeekdays) != 0 {
-				return nil, false, diag.Errorf("timeslots.custom.repeats_on_weekdays cannot be set when timeslots.custom.repeats is not set to 'week'")
+			mcustom := mtimeSlot["custom"].([]interface{})[0].(map[string]interface{})
+			mrepeats := mcustom["repeats"].(string)
+			mrepeatOnWeekdays := mcustom["repeats_on_weekdays"].([]interface{})
+			repeatOnWeekdays := make([]int, len(mrepeatOnWeekdays))
+			repeatsOnMonth := ""
+
+			// ? VALIDATION:
+			// if repeats is week, set repeats_on_weekdays to the value from tfstate
+			// if repeats is not week, set repeats_on_weekdays to nil
+			// if repeats is month, set repeats_on_month to date-occurrence
+
+			switch mrepeats {
+			case "week":
+				for i, v := range mrepeatOnWeekdays {
+					repeatOnWeekdays[i] = v.(int)
+				}
+			case "month":
+				repeatsOnMonth = "date-occurrence"
+			default:
+				if len(mrepeatOnWeekdays) != 0 {
+					return nil, false, diag.Errorf("timeslots.custom.repeats_on_weekdays cannot be set when timeslots.custom.repeats is not set to 'week'")
+				}
+				repeatOnWeekdays = nil
 			}
-			repeatOnWeekdays = nil
+			mtimeSlot["custom"] = api.CustomTime{
+				RepeatsOnMonth:    repeatsOnMonth,
+				RepeatsOnWeekdays: repeatOnWeekdays,
+				RepeatsCount:      mcustom["repeats_count"].(int),
+				Repeats:           mrepeats,
+			}
+			mtimeSlot["is_custom"] = true
 		}
-		mtimeSlot["custom"] = api.CustomTime{
-			RepeatsOnMonth:    repeatsOnMonth,
-			RepeatsOnWeekdays: repeatOnWeekdays,
-			RepeatsCount:      mcustom["repeats_count"].(int),
-			Repeats:           mrepeats,
+
+		var timeslot *api.TimeSlot
+		err := Decode(mtimeSlot, &timeslot)
+		if err != nil {
+			return nil, false, diag.FromErr(err)
 		}
-		mtimeSlot["is_custom"] = true
+		timeslots = append(timeslots, timeslot)
 	}
-	var timeslots []*api.TimeSlot
-	err := Decode(mtimeSlots, &timeslots)
+
+	return timeslots, true, nil
+}
+
+func validateRRule(val interface{}, key string) (warns []string, errs []error) {
+	if _, err := api.ParseRRule(val.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q: %w", key, err))
+	}
+	return
+}
+
+func suppressEquivalentRRule(k, old, new string, d *schema.ResourceData) bool {
+	oldRule, err := api.ParseRRule(old)
 	if err != nil {
-		return nil, false, diag.FromErr(err)
+		return false
 	}
+	newRule, err := api.ParseRRule(new)
+	if err != nil {
+		return false
+	}
+	return oldRule.String() == newRule.String()
+}
 
-	return timeslots, true, nil
+func suppressEquivalentRFC3339Time(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+func suppressEquivalentDuration(k, old, new string, d *schema.ResourceData) bool {
+	oldDuration, err := time.ParseDuration(old)
+	if err != nil {
+		return false
+	}
+	newDuration, err := time.ParseDuration(new)
+	if err != nil {
+		return false
+	}
+	return oldDuration == newDuration
 }
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceSuppressionRuleV2_rrule(t *testing.T) {
+	resourceName := "squadcast_suppression_rule_v2.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSuppressionRuleV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSuppressionRuleV2Config_rrule("FREQ=WEEKLY;BYDAY=SA,SU"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "is_timebased", "true"),
+					resource.TestCheckResourceAttr(resourceName, "timeslots.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "timeslots.0.rrule", "FREQ=WEEKLY;BYDAY=SU,SA"),
+					resource.TestCheckResourceAttr(resourceName, "timeslots.0.repetition", "custom"),
+					resource.TestCheckResourceAttr(resourceName, "timeslots.0.custom.0.repeats", "week"),
+					resource.TestCheckResourceAttr(resourceName, "timeslots.0.custom.0.repeats_on_weekdays.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "timeslots.0.start_time", "2032-06-05T00:00:00.000Z"),
+					resource.TestCheckResourceAttr(resourceName, "timeslots.0.end_time", "2032-06-06T00:00:00.000Z"),
+					resource.TestCheckResourceAttr(resourceName, "timeslots.0.ends_never", "true"),
+				),
+			},
+			{
+				Config: testAccResourceSuppressionRuleV2Config_rrule("FREQ=DAILY;UNTIL=20321231T000000Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "timeslots.0.rrule", "FREQ=DAILY;UNTIL=20321231T000000Z"),
+					resource.TestCheckResourceAttr(resourceName, "timeslots.0.repetition", "daily"),
+					resource.TestCheckResourceAttr(resourceName, "timeslots.0.ends_never", "false"),
+					resource.TestCheckResourceAttr(resourceName, "timeslots.0.ends_on", "2032-12-31T00:00:00.000Z"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSuppressionRuleV2Destroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_suppression_rule_v2" {
+			continue
+		}
+
+		_, err := client.GetSuppressionRuleByID(context.Background(), rs.Primary.Attributes["service_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected suppression rule to be destroyed, %s found", rs.Primary.ID)
+		}
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceSuppressionRuleV2Config_rrule(rrule string) string {
+	return fmt.Sprintf(`
+resource "squadcast_suppression_rule_v2" "test" {
+	service_id = "61361611c2fc70c3101ca7dd"
+	is_basic = false
+	description = "weekend suppression"
+	expression = "payload[\"event_id\"] == 40"
+
+	timeslots {
+		time_zone = "Asia/Calcutta"
+		rrule = "%s"
+		dtstart = "2032-06-05T00:00:00Z"
+		duration = "24h"
+	}
+}
+	`, rrule)
+}
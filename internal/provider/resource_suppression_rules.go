@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -25,6 +24,7 @@ func resourceSuppressionRules() *schema.Resource {
 		ReadContext:   resourceSuppressionRulesRead,
 		UpdateContext: resourceSuppressionRulesUpdate,
 		DeleteContext: resourceSuppressionRulesDelete,
+		CustomizeDiff: customizeDiffDefaultTeamID,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceSuppressionRulesImport,
 		},
@@ -36,9 +36,10 @@ func resourceSuppressionRules() *schema.Resource {
 				Computed:    true,
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
@@ -81,16 +82,11 @@ func resourceSuppressionRules() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"time_zone": {
-										Description: "Time zone for the time slot",
-										Type:        schema.TypeString,
-										Required:    true,
-										ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-											_, err := time.LoadLocation(val.(string))
-											if err != nil {
-												errs = append(errs, err)
-											}
-											return
-										},
+										Description:      "Time zone for the time slot",
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     tf.ValidateTimezone,
+										DiffSuppressFunc: tf.SuppressEquivalentTimezone,
 									},
 									"start_time": {
 										Description: "Defines the start date of the time slot",
@@ -242,6 +238,7 @@ func resourceSuppressionRulesCreate(ctx context.Context, d *schema.ResourceData,
 		if len(mtimeSlots) != 0 {
 			for _, mtimeSlot := range mtimeSlots {
 				mtimeSlot := mtimeSlot.(map[string]interface{})
+				mtimeSlot["time_zone"] = tf.CanonicalTimezone(mtimeSlot["time_zone"].(string))
 				if mtimeSlot["repetition"] != "custom" { // if repetition is not custom, skip
 					mtimeSlot["custom"] = nil
 					continue
@@ -368,6 +365,7 @@ func resourceSuppressionRulesUpdate(ctx context.Context, d *schema.ResourceData,
 		if len(mtimeSlots) != 0 {
 			for _, mtimeSlot := range mtimeSlots {
 				mtimeSlot := mtimeSlot.(map[string]interface{})
+				mtimeSlot["time_zone"] = tf.CanonicalTimezone(mtimeSlot["time_zone"].(string))
 				if mtimeSlot["repetition"] != "custom" {
 					mtimeSlot["custom"] = nil
 					continue
@@ -13,9 +13,9 @@ import (
 func TestAccResourceSuppressionRules(t *testing.T) {
 	resourceName := "squadcast_suppression_rules.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckSuppressionRulesDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSuppressionRulesDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceSuppressionRulesConfig(),
@@ -21,6 +21,7 @@ func resourceTaggingRules() *schema.Resource {
 		ReadContext:   resourceTaggingRulesRead,
 		UpdateContext: resourceTaggingRulesUpdate,
 		DeleteContext: resourceTaggingRulesDelete,
+		CustomizeDiff: customizeDiffDefaultTeamID,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTaggingRulesImport,
 		},
@@ -32,9 +33,10 @@ func resourceTaggingRules() *schema.Resource {
 				Computed:    true,
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
@@ -23,9 +23,9 @@ func TestAccResourceTaggingRules(t *testing.T) {
 	serviceResourceName := "squadcast_service.test"
 	resourceName := "squadcast_tagging_rules.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckTaggingRulesDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaggingRulesDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceTaggingRulesConfig(teamName, user, epName, serviceName),
@@ -19,6 +19,7 @@ func resourceTeamMember() *schema.Resource {
 		ReadContext:   resourceTeamMemberRead,
 		UpdateContext: resourceTeamMemberUpdate,
 		DeleteContext: resourceTeamMemberDelete,
+		CustomizeDiff: customizeDiffDefaultTeamID,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTeamMemberImport,
 		},
@@ -30,9 +31,10 @@ func resourceTeamMember() *schema.Resource {
 				Computed:    true,
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
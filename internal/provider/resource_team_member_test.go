@@ -21,9 +21,9 @@ func TestAccResourceTeamMember(t *testing.T) {
 	userResourceName := "squadcast_user.test"
 	resourceName := "squadcast_team_member.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckTeamMemberDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTeamMemberDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceTeamMemberConfig(teamName, user),
@@ -16,9 +16,9 @@ func TestAccResourceTeam(t *testing.T) {
 
 	resourceName := "squadcast_team.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckTeamDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTeamDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceTeamConfig(teamName),
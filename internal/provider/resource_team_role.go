@@ -19,6 +19,7 @@ func resourceTeamRole() *schema.Resource {
 		ReadContext:   resourceTeamRoleRead,
 		UpdateContext: resourceTeamRoleUpdate,
 		DeleteContext: resourceTeamRoleDelete,
+		CustomizeDiff: customizeDiffDefaultTeamID,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTeamRoleImport,
 		},
@@ -30,9 +31,10 @@ func resourceTeamRole() *schema.Resource {
 				Computed:    true,
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
@@ -19,9 +19,9 @@ func TestAccResourceTeamRole(t *testing.T) {
 	teamResourceName := "squadcast_team.test"
 	resourceName := "squadcast_team_role.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckTeamRoleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTeamRoleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceTeamRoleConfig(teamName, teamRoleName),
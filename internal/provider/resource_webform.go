@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
@@ -20,9 +21,11 @@ func resourceWebform() *schema.Resource {
 		ReadContext:   resourceWebformRead,
 		UpdateContext: resourceWebformUpdate,
 		DeleteContext: resourceWebformDelete,
+		CustomizeDiff: customdiff.All(customizeDiffDefaultTeamID, customizeDiffTagsAll),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceWebformImport,
 		},
+		Identity: teamResourceIdentity(),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -36,9 +39,10 @@ func resourceWebform() *schema.Resource {
 				Required:    true,
 			},
 			"team_id": {
-				Description:  "Team id.",
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
@@ -120,6 +124,7 @@ func resourceWebform() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"tags_all": tagsAllSchema(),
 			"services": {
 				Description: "Services added to Webform.",
 				Type:        schema.TypeList,
@@ -174,6 +179,10 @@ func resourceWebform() *schema.Resource {
 }
 
 func resourceWebformImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		return importIdentity(d, "team_id")
+	}
+
 	client := meta.(*api.Client)
 	teamID, webformName, err := parse2PartImportID(d.Id())
 	if err != nil {
@@ -252,17 +261,11 @@ func resourceWebformCreate(ctx context.Context, d *schema.ResourceData, meta any
 	}
 	webformCreateReq.InputField = inputField
 
-	mtags := d.Get("tags").(map[string]interface{})
-	tags := make(map[string]string, len(mtags))
-	for k, v := range mtags {
-		tags[k] = v.(string)
-	}
-
-	webformCreateReq.Tags = tags
+	webformCreateReq.Tags = mergeDefaultTags(client, tagsToMap(d.Get("tags")))
 
 	webformRes, err := client.CreateWebform(ctx, d.Get("team_id").(string), &webformCreateReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromAPIError(err)
 	}
 	webform := webformRes.WebFormRes
 
@@ -296,10 +299,19 @@ func resourceWebformRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return diag.FromErr(err)
 	}
 
+	tags := d.Get("tags")
 	if err = tf.EncodeAndSet(webform, d); err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err = setTagsAll(d, client, tags); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = setIdentity(d, "team_id"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -352,17 +364,11 @@ func resourceWebformUpdate(ctx context.Context, d *schema.ResourceData, meta any
 	}
 	webformUpdateReq.InputField = inputField
 
-	mtags := d.Get("tags").(map[string]interface{})
-	tags := make(map[string]string, len(mtags))
-	for k, v := range mtags {
-		tags[k] = v.(string)
-	}
-
-	webformUpdateReq.Tags = tags
+	webformUpdateReq.Tags = mergeDefaultTags(client, tagsToMap(d.Get("tags")))
 
 	_, err = client.UpdateWebform(ctx, d.Get("team_id").(string), d.Id(), &webformUpdateReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromAPIError(err)
 	}
 	return resourceWebformRead(ctx, d, meta)
 }
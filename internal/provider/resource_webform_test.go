@@ -16,9 +16,9 @@ func TestAccResourceWebform(t *testing.T) {
 	resourceName := "squadcast_webform.test"
 
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckWebformDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebformDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceWebformConfigWithInputFields(webformName),
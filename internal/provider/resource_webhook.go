@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceWebhook() *schema.Resource {
+	return &schema.Resource{
+		Description: "Squadcast Webhooks send incident events to an external URL. Automatic webhooks are sent on the events of their triggers, manual webhooks are sent from an incident or by the `sq_trigger_manual_webhook` action of a workflow.",
+
+		CreateContext: resourceWebhookCreate,
+		ReadContext:   resourceWebhookRead,
+		UpdateContext: resourceWebhookUpdate,
+		DeleteContext: resourceWebhookDelete,
+		CustomizeDiff: customdiff.All(customizeDiffDefaultTeamID, customizeDiffWebhookTriggers),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWebhookImport,
+		},
+		Identity: teamResourceIdentity(),
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Webhook id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description:  "Name of the Webhook.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Description: "Description of the Webhook.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"team_id": {
+				Description:  "Team id. Defaults to the `default_team_id` of the provider.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"type": {
+				Description:  "Type of the Webhook. Supported values are 'automatic', sent on the events of `triggers`, or 'manual'. Defaults to 'automatic'.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "automatic",
+				ValidateFunc: validation.StringInSlice([]string{"automatic", "manual"}, false),
+			},
+			"url": {
+				Description:  "URL the Webhook is sent to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"triggers": {
+				Description: "Events which send an automatic Webhook.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_class": {
+							Description: "Class of the event, e.g. 'incident'.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"event_type": {
+							Description: "Type of the event, e.g. 'triggered', 'acknowledged' or 'resolved'.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"headers": {
+				Description: "Headers sent with the Webhook.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Description: "Header name.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"value": {
+							Description: "Header value.",
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"payload_template": {
+				Description: "Custom payload of the Webhook, a template of the incident fields. The default payload is sent when unset.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"entity_owner": {
+				Description: "Webhook owner. Defaults to the owner set by Squadcast.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description:  "Webhook owner id.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: tf.ValidateObjectID,
+						},
+						"type": {
+							Description:  "Webhook owner type. Supported values are 'user', 'squad' or 'team'.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"user", "squad", "team"}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// customizeDiffWebhookTriggers checks that only automatic webhooks, and all of them, have triggers.
+func customizeDiffWebhookTriggers(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("triggers") {
+		return nil
+	}
+
+	hasTriggers := len(d.Get("triggers").([]any)) > 0
+	switch d.Get("type").(string) {
+	case "automatic":
+		if !hasTriggers {
+			return errors.New("triggers must be set when type is automatic")
+		}
+	case "manual":
+		if hasTriggers {
+			return errors.New("triggers can only be set when type is automatic")
+		}
+	}
+
+	return nil
+}
+
+func resourceWebhookImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		return importIdentity(d, "team_id")
+	}
+
+	client := meta.(*api.Client)
+
+	teamID, name, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := client.GetWebhookByName(ctx, teamID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.SetId(webhook.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandWebhook(d *schema.ResourceData) (*api.CreateUpdateWebhookReq, error) {
+	req := &api.CreateUpdateWebhookReq{
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		OwnerID:       d.Get("team_id").(string),
+		Type:          d.Get("type").(string),
+		URL:           d.Get("url").(string),
+		CustomPayload: d.Get("payload_template").(string),
+		Triggers:      []*api.WebhookTrigger{},
+		Headers:       []*api.WebhookHeader{},
+	}
+	req.IsCustomPayloadEnabled = req.CustomPayload != ""
+
+	if err := Decode(d.Get("triggers"), &req.Triggers); err != nil {
+		return nil, err
+	}
+	if err := Decode(d.Get("headers"), &req.Headers); err != nil {
+		return nil, err
+	}
+
+	mentityOwner := d.Get("entity_owner").([]any)
+	if len(mentityOwner) > 0 {
+		entityOwnerMap, ok := mentityOwner[0].(map[string]any)
+		if !ok {
+			return nil, errors.New("entity_owner is invalid")
+		}
+		req.EntityOwner = &api.EntityOwner{
+			ID:   entityOwnerMap["id"].(string),
+			Type: entityOwnerMap["type"].(string),
+		}
+	}
+
+	return req, nil
+}
+
+func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := expandWebhook(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating webhook", tf.M{
+		"name": req.Name,
+	})
+	webhook, err := client.CreateWebhook(ctx, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(webhook.ID)
+
+	return resourceWebhookRead(ctx, d, meta)
+}
+
+func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading webhook", tf.M{
+		"id":   d.Id(),
+		"name": d.Get("name").(string),
+	})
+	webhook, err := client.GetWebhookById(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(webhook, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = setIdentity(d, "team_id"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := expandWebhook(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Updating webhook", tf.M{
+		"id":   d.Id(),
+		"name": req.Name,
+	})
+	if _, err = client.UpdateWebhook(ctx, d.Id(), req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceWebhookRead(ctx, d, meta)
+}
+
+func resourceWebhookDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Deleting webhook", tf.M{
+		"id": d.Id(),
+	})
+	_, err := client.DeleteWebhook(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
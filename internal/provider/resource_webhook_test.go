@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceWebhook(t *testing.T) {
+	webhookName := acctest.RandomWithPrefix("webhook")
+
+	resourceName := "squadcast_webhook.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWebhookConfig(webhookName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "name", webhookName),
+					resource.TestCheckResourceAttr(resourceName, "type", "automatic"),
+					resource.TestCheckResourceAttr(resourceName, "url", "https://example.com/webhook"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.0.event_class", "incident"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.0.event_type", "triggered"),
+					resource.TestCheckResourceAttr(resourceName, "headers.0.key", "Authorization"),
+					resource.TestCheckResourceAttr(resourceName, "headers.0.value", "Bearer token"),
+					resource.TestCheckResourceAttr(resourceName, "payload_template", ""),
+				),
+			},
+			{
+				Config: testAccResourceWebhookConfig_update(webhookName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", webhookName),
+					resource.TestCheckResourceAttr(resourceName, "type", "manual"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "payload_template", `{"id": "{{incident.id}}"}`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f:" + webhookName,
+			},
+		},
+	})
+}
+
+func TestAccResourceWebhookTriggers(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "squadcast_webhook" "test" {
+	name = "webhook"
+	team_id = "613611c1eb22db455cfa789f"
+	url = "https://example.com/webhook"
+}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`triggers must be set when type is automatic`),
+			},
+		},
+	})
+}
+
+func testAccCheckWebhookDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_webhook" {
+			continue
+		}
+
+		_, err := client.GetWebhookById(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected webhook to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceWebhookConfig(webhookName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_webhook" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	url = "https://example.com/webhook"
+
+	triggers {
+		event_class = "incident"
+		event_type = "triggered"
+	}
+
+	headers {
+		key = "Authorization"
+		value = "Bearer token"
+	}
+}
+	`, webhookName)
+}
+
+func testAccResourceWebhookConfig_update(webhookName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_webhook" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	type = "manual"
+	url = "https://example.com/webhook"
+	payload_template = "{\"id\": \"{{incident.id}}\"}"
+
+	headers {
+		key = "Authorization"
+		value = "Bearer token"
+	}
+}
+	`, webhookName)
+}
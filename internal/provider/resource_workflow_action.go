@@ -2,422 +2,812 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
-func resourceWorkflowAction() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceWorkflowActionCreate,
-		ReadContext:   resourceWorkflowActionRead,
-		UpdateContext: resourceWorkflowActionUpdate,
-		DeleteContext: resourceWorkflowActionDelete,
-		Schema: map[string]*schema.Schema{
-			"workflow_id": {
-				Type:        schema.TypeString,
-				Description: "The ID of the workflow to which this action belongs",
-				Required:    true,
-			},
-			"name": {
-				Type:        schema.TypeString,
-				Description: "The name of the action",
-				Required:    true,
-				ValidateFunc: validation.StringInSlice([]string{"sq_add_incident_note", "sq_attach_runbooks",
-					"sq_mark_incident_slo_affecting", "sq_add_communication_channel", "sq_update_incident_priority",
-					"sq_make_http_call", "sq_send_email", "sq_trigger_manual_webhook", "sq_add_status_page_issue", "jira_create_ticket",
-					"slack_create_incident_channel", "slack_archive_channel", "slack_message_channel", "slack_message_user",
-					"msteams_message_channel", "msteams_message_user"}, false),
+var (
+	_ resource.ResourceWithConfigure      = &workflowActionResource{}
+	_ resource.ResourceWithValidateConfig = &workflowActionResource{}
+	_ resource.ResourceWithUpgradeState   = &workflowActionResource{}
+)
+
+type workflowActionResource struct {
+	client *api.Client
+}
+
+type workflowActionModel struct {
+	ID                      types.String                                 `tfsdk:"id"`
+	WorkflowID              types.String                                 `tfsdk:"workflow_id"`
+	Name                    types.String                                 `tfsdk:"name"`
+	AddNote                 []workflowActionAddNoteModel                 `tfsdk:"add_note"`
+	AttachRunbooks          []workflowActionAttachRunbooksModel          `tfsdk:"attach_runbooks"`
+	MarkSLOAffecting        []workflowActionMarkSLOAffectingModel        `tfsdk:"mark_slo_affecting"`
+	AddCommunicationChannel []workflowActionAddCommunicationChannelModel `tfsdk:"add_communication_channel"`
+	UpdatePriority          []workflowActionUpdatePriorityModel          `tfsdk:"update_priority"`
+	HTTPCall                []workflowActionHTTPCallModel                `tfsdk:"http_call"`
+	SendEmail               []workflowActionSendEmailModel               `tfsdk:"send_email"`
+	TriggerWebhook          []workflowActionTriggerWebhookModel          `tfsdk:"trigger_webhook"`
+	StatusPageUpdate        []workflowActionStatusPageUpdateModel        `tfsdk:"status_page_update"`
+	JiraCreateTicket        []workflowActionJiraCreateTicketModel        `tfsdk:"jira_create_ticket"`
+	SlackCreateChannel      []workflowActionSlackCreateChannelModel      `tfsdk:"slack_create_channel"`
+	SlackMessageChannel     []workflowActionMessageChannelModel          `tfsdk:"slack_message_channel"`
+	SlackMessageUser        []workflowActionMessageUserModel             `tfsdk:"slack_message_user"`
+	MSTeamsMessageChannel   []workflowActionMessageChannelModel          `tfsdk:"msteams_message_channel"`
+	MSTeamsMessageUser      []workflowActionMessageUserModel             `tfsdk:"msteams_message_user"`
+}
+
+// workflowActionBlocks maps the name of an action to the block holding its settings. Actions without
+// settings have no block.
+var workflowActionBlocks = map[string]string{
+	"sq_add_incident_note":           "add_note",
+	"sq_attach_runbooks":             "attach_runbooks",
+	"sq_mark_incident_slo_affecting": "mark_slo_affecting",
+	"sq_add_communication_channel":   "add_communication_channel",
+	"sq_update_incident_priority":    "update_priority",
+	"sq_make_http_call":              "http_call",
+	"sq_send_email":                  "send_email",
+	"sq_trigger_manual_webhook":      "trigger_webhook",
+	"sq_add_status_page_issue":       "status_page_update",
+	"jira_create_ticket":             "jira_create_ticket",
+	"slack_create_incident_channel":  "slack_create_channel",
+	"slack_message_channel":          "slack_message_channel",
+	"slack_message_user":             "slack_message_user",
+	"msteams_message_channel":        "msteams_message_channel",
+	"msteams_message_user":           "msteams_message_user",
+}
+
+type workflowActionAddNoteModel struct {
+	Note types.String `tfsdk:"note"`
+}
+
+type workflowActionAttachRunbooksModel struct {
+	Runbooks []types.String `tfsdk:"runbooks"`
+}
+
+type workflowActionMarkSLOAffectingModel struct {
+	SLO  types.Int64    `tfsdk:"slo"`
+	SLIs []types.String `tfsdk:"slis"`
+}
+
+type workflowActionAddCommunicationChannelModel struct {
+	Channels []workflowActionChannelModel `tfsdk:"channels"`
+}
+
+type workflowActionUpdatePriorityModel struct {
+	Priority types.String `tfsdk:"priority"`
+}
+
+type workflowActionHTTPCallModel struct {
+	Method  types.String                `tfsdk:"method"`
+	URL     types.String                `tfsdk:"url"`
+	Headers []workflowActionHeaderModel `tfsdk:"headers"`
+	Body    types.String                `tfsdk:"body"`
+}
+
+type workflowActionSendEmailModel struct {
+	To      []types.String `tfsdk:"to"`
+	Subject types.String   `tfsdk:"subject"`
+	Body    types.String   `tfsdk:"body"`
+}
+
+type workflowActionTriggerWebhookModel struct {
+	WebhookID types.String `tfsdk:"webhook_id"`
+}
+
+type workflowActionStatusPageUpdateModel struct {
+	StatusPageID       types.Int64                             `tfsdk:"status_page_id"`
+	IssueTitle         types.String                            `tfsdk:"issue_title"`
+	PageStatusID       types.Int64                             `tfsdk:"page_status_id"`
+	ComponentAndImpact []workflowActionComponentAndImpactModel `tfsdk:"component_and_impact"`
+	StatusAndMessage   []workflowActionStatusAndMessageModel   `tfsdk:"status_and_message"`
+}
+
+type workflowActionJiraCreateTicketModel struct {
+	Account     types.String `tfsdk:"account"`
+	Project     types.String `tfsdk:"project"`
+	IssueType   types.String `tfsdk:"issue_type"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+}
+
+type workflowActionSlackCreateChannelModel struct {
+	AutoName    types.Bool   `tfsdk:"auto_name"`
+	ChannelName types.String `tfsdk:"channel_name"`
+}
+
+type workflowActionMessageChannelModel struct {
+	ChannelID types.String `tfsdk:"channel_id"`
+	Message   types.String `tfsdk:"message"`
+}
+
+type workflowActionMessageUserModel struct {
+	MemberID types.String `tfsdk:"member_id"`
+	Message  types.String `tfsdk:"message"`
+}
+
+type workflowActionChannelModel struct {
+	Type        types.String `tfsdk:"type"`
+	Link        types.String `tfsdk:"link"`
+	DisplayText types.String `tfsdk:"display_text"`
+}
+
+type workflowActionHeaderModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+type workflowActionComponentAndImpactModel struct {
+	ComponentID    types.Int64 `tfsdk:"component_id"`
+	ImpactStatusID types.Int64 `tfsdk:"impact_status_id"`
+}
+
+type workflowActionStatusAndMessageModel struct {
+	StatusID types.Int64    `tfsdk:"status_id"`
+	Messages []types.String `tfsdk:"messages"`
+}
+
+func NewWorkflowActionResource() resource.Resource {
+	return &workflowActionResource{}
+}
+
+func (r *workflowActionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_action"
+}
+
+func (r *workflowActionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	requiredString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Required:            true,
+		}
+	}
+	optionalString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+		}
+	}
+	requiredInt64 := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: description,
+			Required:            true,
+		}
+	}
+	stringList := func(description string, required bool) schema.ListAttribute {
+		return schema.ListAttribute{
+			MarkdownDescription: description,
+			Required:            required,
+			Optional:            !required,
+			ElementType:         types.StringType,
+		}
+	}
+	// actionBlock is the block of the settings of an action type, it may only be set once.
+	actionBlock := func(name, description string, attributes map[string]schema.Attribute, blocks map[string]schema.Block) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			MarkdownDescription: fmt.Sprintf("%s. Only for the `%s` action.", description, name),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: attributes,
+				Blocks:     blocks,
 			},
-			// Add Notes Action
-			"note": {
-				Type:        schema.TypeString,
-				Description: "The note to be added to the incident",
-				Optional:    true,
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
 			},
-			// Attach Runbooks Action
-			"runbooks": {
-				Type:        schema.TypeList,
-				Description: "The runbooks to be added to the incident",
-				Optional:    true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Version: 2,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			// SLO Action
-			"slo": {
-				Type:        schema.TypeInt,
-				Description: "ID of the SLO to be added to the incident",
-				Optional:    true,
+			"workflow_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workflow to which this action belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"slis": {
-				Type:        schema.TypeList,
-				Description: "The SLIs to be added to the incident",
-				Optional:    true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the action. The settings of the action are set in the block of its type, `slack_archive_channel` has no settings.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("sq_add_incident_note", "sq_attach_runbooks",
+						"sq_mark_incident_slo_affecting", "sq_add_communication_channel", "sq_update_incident_priority",
+						"sq_make_http_call", "sq_send_email", "sq_trigger_manual_webhook", "sq_add_status_page_issue", "jira_create_ticket",
+						"slack_create_incident_channel", "slack_archive_channel", "slack_message_channel", "slack_message_user",
+						"msteams_message_channel", "msteams_message_user"),
 				},
 			},
-			// Communication Channel Action
-			"channels": {
-				Type:        schema.TypeList,
-				Description: "The communication channels to be added to the incident",
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"type": {
-							Type:         schema.TypeString,
-							Description:  "The type of the communication channel",
-							ValidateFunc: validation.StringInSlice([]string{"chat_room", "video_conference", "other"}, false),
-							Required:     true,
-						},
-						"link": {
-							Type:        schema.TypeString,
-							Description: "The link of the communication channel",
-							Required:    true,
-						},
-						"display_text": {
-							Type:        schema.TypeString,
-							Description: "The display text of the communication channel",
-							Required:    true,
+		},
+
+		Blocks: map[string]schema.Block{
+			"add_note": actionBlock("sq_add_incident_note", "Adds a note to the incident", map[string]schema.Attribute{
+				"note": requiredString("The note to be added to the incident"),
+			}, nil),
+			"attach_runbooks": actionBlock("sq_attach_runbooks", "Attaches runbooks to the incident", map[string]schema.Attribute{
+				"runbooks": stringList("The IDs of the runbooks to be added to the incident", true),
+			}, nil),
+			"mark_slo_affecting": actionBlock("sq_mark_incident_slo_affecting", "Marks the incident as affecting an SLO", map[string]schema.Attribute{
+				"slo":  requiredInt64("ID of the SLO to be added to the incident"),
+				"slis": stringList("The SLIs to be added to the incident", false),
+			}, nil),
+			"add_communication_channel": actionBlock("sq_add_communication_channel", "Adds communication channels to the incident", nil, map[string]schema.Block{
+				"channels": schema.ListNestedBlock{
+					MarkdownDescription: "The communication channels to be added to the incident",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								MarkdownDescription: "The type of the communication channel",
+								Required:            true,
+								Validators: []validator.String{
+									stringvalidator.OneOf("chat_room", "video_conference", "other"),
+								},
+							},
+							"link":         requiredString("The link of the communication channel"),
+							"display_text": requiredString("The display text of the communication channel"),
 						},
 					},
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
 				},
-			},
-			// Incident Priority Action
-			"priority": {
-				Type:         schema.TypeString,
-				Description:  "The priority of the incident",
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"P1", "P2", "P3", "P4", "P5", "UNSET"}, false),
-			},
-			// HTTP Call Action
-			"method": {
-				Type:         schema.TypeString,
-				Description:  "The HTTP method to be used for the call",
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"GET", "POST", "PUT", "PATCH", "DELETE"}, false),
-			},
-			"url": {
-				Type:        schema.TypeString,
-				Description: "The URL to be called",
-				Optional:    true,
-			},
-			"headers": {
-				Type:        schema.TypeList,
-				Description: "The headers to be sent with the request",
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"key": {
-							Type:        schema.TypeString,
-							Description: "The key of the header",
-							Required:    true,
-						},
-						"value": {
-							Type:        schema.TypeString,
-							Description: "The value of the header",
-							Required:    true,
-						},
+			}),
+			"update_priority": actionBlock("sq_update_incident_priority", "Updates the priority of the incident", map[string]schema.Attribute{
+				"priority": schema.StringAttribute{
+					MarkdownDescription: "The priority of the incident",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("P1", "P2", "P3", "P4", "P5", "UNSET"),
 					},
 				},
-			},
-			"body": {
-				Type:        schema.TypeString,
-				Description: "The body of the request",
-				Optional:    true,
-			},
-			// Send Email Action
-			"to": {
-				Type:        schema.TypeList,
-				Description: "The email addresses to which the email is to be sent",
-				Optional:    true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+			}, nil),
+			"http_call": actionBlock("sq_make_http_call", "Makes an HTTP call", map[string]schema.Attribute{
+				"method": schema.StringAttribute{
+					MarkdownDescription: "The HTTP method to be used for the call",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("GET", "POST", "PUT", "PATCH", "DELETE"),
+					},
 				},
-			},
-			"subject": {
-				Type:        schema.TypeString,
-				Description: "The subject of the email",
-				Optional:    true,
-			},
-			// body is needed for email as well
-			// Trigger Manual Webhook Action
-			"webhook_id": {
-				Type:        schema.TypeString,
-				Description: "The ID of the webhook to be triggered. (Only for Trigger Manual Webhook action)",
-				Optional:    true,
-			},
-			// Status Page Issue Action
-			"status_page_id": {
-				Type:        schema.TypeInt,
-				Description: "The ID of the status page to which the issue is to be added. (Only for Add Status Page Issue action)",
-				Optional:    true,
-			},
-			"issue_title": {
-				Type:        schema.TypeString,
-				Description: "The title of the issue to be added. (Only for Add Status Page Issue action)",
-				Optional:    true,
-			},
-			"page_status_id": {
-				Type:        schema.TypeInt,
-				Description: "The ID of the status to be set for the issue. (Only for Add Status Page Issue action)",
-				Optional:    true,
-			},
-			"component_and_impact": {
-				Type:        schema.TypeList,
-				Description: "The components and their impact to be set for the issue. (Only for Add Status Page Issue action)",
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"component_id": {
-							Type:        schema.TypeInt,
-							Description: "The ID of the component",
-							Required:    true,
-						},
-						"impact_status_id": {
-							Type:        schema.TypeInt,
-							Description: "The ID of the impact status",
-							Required:    true,
+				"url":  requiredString("The URL to be called"),
+				"body": optionalString("The body of the request"),
+			}, map[string]schema.Block{
+				"headers": schema.ListNestedBlock{
+					MarkdownDescription: "The headers to be sent with the request",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"key":   requiredString("The key of the header"),
+							"value": requiredString("The value of the header"),
 						},
 					},
 				},
-			},
-			"status_and_message": {
-				Type:        schema.TypeList,
-				Description: "The status and message to be set for the issue. (Only for Add Status Page Issue action)",
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"status_id": {
-							Type:        schema.TypeInt,
-							Description: "The ID of the status",
-							Required:    true,
+			}),
+			"send_email": actionBlock("sq_send_email", "Sends an email", map[string]schema.Attribute{
+				"to":      stringList("The email addresses to which the email is to be sent", true),
+				"subject": requiredString("The subject of the email"),
+				"body":    optionalString("The body of the email"),
+			}, nil),
+			"trigger_webhook": actionBlock("sq_trigger_manual_webhook", "Triggers a manual webhook", map[string]schema.Attribute{
+				"webhook_id": requiredString("The ID of the manual webhook to be triggered, e.g. the `id` of a `squadcast_webhook`"),
+			}, nil),
+			"status_page_update": actionBlock("sq_add_status_page_issue", "Adds an issue to a status page", map[string]schema.Attribute{
+				"status_page_id": requiredInt64("The ID of the status page to which the issue is to be added"),
+				"issue_title":    requiredString("The title of the issue to be added"),
+				"page_status_id": requiredInt64("The ID of the status to be set for the issue, e.g. from the `statuses` of a `squadcast_status_page_statuses`"),
+			}, map[string]schema.Block{
+				"component_and_impact": schema.ListNestedBlock{
+					MarkdownDescription: "The components and their impact to be set for the issue",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"component_id":     requiredInt64("The ID of the component"),
+							"impact_status_id": requiredInt64("The ID of the impact status, e.g. from the `impacts` of a `squadcast_status_page_statuses`"),
 						},
-						"messages": {
-							Type:        schema.TypeList,
-							Description: "The messages to be set for the issue",
-							Optional:    true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
+					},
+				},
+				"status_and_message": schema.ListNestedBlock{
+					MarkdownDescription: "The status and message to be set for the issue",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"status_id": requiredInt64("The ID of the status, e.g. from the `statuses` of a `squadcast_status_page_statuses`"),
+							"messages":  stringList("The messages to be set for the issue", false),
 						},
 					},
 				},
-			},
-			// Jira Create Ticket Action
-			"account": {
-				Type:        schema.TypeString,
-				Description: "The account to be used for creating the ticket. (Only for Jira Create Ticket action)",
-				Optional:    true,
-			},
-			"project": {
-				Type:        schema.TypeString,
-				Description: "The project to be used for creating the ticket. (Only for Jira Create Ticket action)",
-				Optional:    true,
-			},
-			"issue_type": {
-				Type:        schema.TypeString,
-				Description: "The issue type to be used for creating the ticket. (Only for Jira Create Ticket action)",
-				Optional:    true,
-			},
-			"title": {
-				Type:        schema.TypeString,
-				Description: "The title of the ticket. (Only for Jira Create Ticket action)",
-				Optional:    true,
-			},
-			"description": {
-				Type:        schema.TypeString,
-				Description: "The description of the ticket. (Only for Jira Create Ticket action)",
-				Optional:    true,
-			},
-			// Slack:  Channel Creation Action
-			"auto_name": {
-				Type:        schema.TypeBool,
-				Description: "Whether to automatically name the action",
-				Optional:    true,
-			},
-			"channel_name": {
-				Type:        schema.TypeString,
-				Description: "The name of the channel to be archived. (Only for Slack Archive Channel action)",
-				Optional:    true,
-			},
-			// Slack & MS-Team: Send message to channel
-			"channel_id": {
-				Type:        schema.TypeString,
-				Description: "The ID of the channel to which the message is to be sent. (Only for Slack/MS-Team Message Channel action)",
-				Optional:    true,
-			},
-			"message": {
-				Type:        schema.TypeString,
-				Description: "The message to be sent. (Only for Slack/MS-Team Message Channel action)",
-				Optional:    true,
-			},
-			// Slack & MS-Team: Send message to user
-			"member_id": {
-				Type:        schema.TypeString,
-				Description: "The ID of the user to which the message is to be sent. (Only for Slack Message User action)",
-				Optional:    true,
-			},
-			//message is needed for this as well
+			}),
+			"jira_create_ticket": actionBlock("jira_create_ticket", "Creates a Jira ticket", map[string]schema.Attribute{
+				"account":     requiredString("The account to be used for creating the ticket"),
+				"project":     requiredString("The project to be used for creating the ticket"),
+				"issue_type":  requiredString("The issue type to be used for creating the ticket"),
+				"title":       requiredString("The title of the ticket"),
+				"description": optionalString("The description of the ticket"),
+			}, nil),
+			"slack_create_channel": actionBlock("slack_create_incident_channel", "Creates a Slack channel for the incident", map[string]schema.Attribute{
+				"auto_name": schema.BoolAttribute{
+					MarkdownDescription: "Whether to automatically name the channel",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+				"channel_name": optionalString("The name of the channel to be created"),
+			}, nil),
+			"slack_message_channel": actionBlock("slack_message_channel", "Sends a message to a Slack channel", map[string]schema.Attribute{
+				"channel_id": requiredString("The ID of the channel to which the message is to be sent"),
+				"message":    requiredString("The message to be sent"),
+			}, nil),
+			"slack_message_user": actionBlock("slack_message_user", "Sends a message to a Slack user", map[string]schema.Attribute{
+				"member_id": requiredString("The ID of the user to which the message is to be sent"),
+				"message":   requiredString("The message to be sent"),
+			}, nil),
+			"msteams_message_channel": actionBlock("msteams_message_channel", "Sends a message to an MS Teams channel", map[string]schema.Attribute{
+				"channel_id": requiredString("The ID of the channel to which the message is to be sent"),
+				"message":    requiredString("The message to be sent"),
+			}, nil),
+			"msteams_message_user": actionBlock("msteams_message_user", "Sends a message to an MS Teams user", map[string]schema.Attribute{
+				"member_id": requiredString("The ID of the user to which the message is to be sent"),
+				"message":   requiredString("The message to be sent"),
+			}, nil),
 		},
 	}
 }
 
-func resourceWorkflowActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-
-	client := meta.(*api.Client)
-
-	tflog.Info(ctx, "Creating a new workflow action", tf.M{
-		"name":        d.Get("name").(string),
-		"worfklow_id": d.Get("workflow_id").(string),
-	})
+func (r *workflowActionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*api.Client)
+}
 
-	workflowAction, derr := createWorkflowAction(d)
-	if derr != nil {
-		return derr
+// ValidateConfig checks that only the block of the action type is set. The blocks are read one by one
+// as they may be unknown when they are dynamic.
+func (r *workflowActionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || name.IsNull() || name.IsUnknown() {
+		return
 	}
 
-	workflowID := d.Get("workflow_id").(string)
+	want := workflowActionBlocks[name.ValueString()]
+	for _, action := range slices.Sorted(maps.Keys(workflowActionBlocks)) {
+		block := workflowActionBlocks[action]
+		var list types.List
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(block), &list)...)
+		if list.IsUnknown() {
+			continue
+		}
 
-	workflowActionResponse, err := client.CreateWorkflowAction(ctx, workflowID, workflowAction)
-	if err != nil {
-		return diag.FromErr(err)
+		set := len(list.Elements()) > 0
+		switch {
+		case block == want && !set:
+			resp.Diagnostics.AddAttributeError(path.Root(block), fmt.Sprintf("Missing %s block", block), fmt.Sprintf("%s must be set when name is %s", block, action))
+		case block != want && set:
+			resp.Diagnostics.AddAttributeError(path.Root(block), fmt.Sprintf("Invalid %s block", block), fmt.Sprintf("%s can only be set when name is %s", block, action))
+		}
 	}
+}
 
-	workflowActionID := strconv.FormatUint(uint64(workflowActionResponse.ID), 10)
-	d.SetId(workflowActionID)
-
-	return resourceWorkflowActionRead(ctx, d, meta)
+// UpgradeState moves the flat attributes of the prior versions into the block of the action type.
+// Version 0 is the state written by the former SDKv2 implementation of this resource.
+func (r *workflowActionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: upgradeWorkflowActionStateV1(true),
+		1: upgradeWorkflowActionStateV1(false),
+	}
 }
 
-func resourceWorkflowActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (r *workflowActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !readOnlyFrameworkGuard(r.client, "squadcast_workflow_action", "create", "", &resp.Diagnostics) {
+		return
+	}
 
-	client := meta.(*api.Client)
+	var plan workflowActionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	tflog.Info(ctx, "Updating workflow action", tf.M{
-		"worfklow_id": d.Get("workflow_id").(string),
-		"action_id":   d.Id(),
+	tflog.Info(ctx, "Creating a new workflow action", tf.M{
+		"name":        plan.Name.ValueString(),
+		"workflow_id": plan.WorkflowID.ValueString(),
 	})
 
-	workflowAction, derr := createWorkflowAction(d)
-	if derr != nil {
-		return derr
+	workflowActionResponse, err := r.client.CreateWorkflowAction(ctx, plan.WorkflowID.ValueString(), plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create the workflow action", err.Error())
+		return
 	}
 
-	workflowID := d.Get("workflow_id").(string)
+	plan.ID = types.StringValue(strconv.FormatUint(uint64(workflowActionResponse.ID), 10))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workflow_id"), plan.WorkflowID)...)
 
-	_, err := client.UpdateWorkflowAction(ctx, workflowID, d.Id(), workflowAction)
-	if err != nil {
-		return diag.FromErr(err)
+	r.read(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return resourceWorkflowActionRead(ctx, d, meta)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceWorkflowActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*api.Client)
+func (r *workflowActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workflowActionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Info(ctx, "Reading workflow action", tf.M{
-		"name":        d.Get("name").(string),
-		"action_id":   d.Id(),
-		"worfklow_id": d.Get("workflow_id").(string),
+		"name":        state.Name.ValueString(),
+		"action_id":   state.ID.ValueString(),
+		"workflow_id": state.WorkflowID.ValueString(),
 	})
 
-	workflowID := d.Get("workflow_id").(string)
-	workflowActionID := d.Id()
+	found := r.read(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
-	workflowAction, err := client.GetWorkflowActionById(ctx, workflowID, workflowActionID)
-	if err != nil {
-		return diag.FromErr(err)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *workflowActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan workflowActionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !readOnlyFrameworkGuard(r.client, "squadcast_workflow_action", "update", plan.ID.ValueString(), &resp.Diagnostics) {
+		return
 	}
 
-	if err = tf.EncodeAndSet(workflowAction, d); err != nil {
-		return diag.FromErr(err)
+	tflog.Info(ctx, "Updating workflow action", tf.M{
+		"workflow_id": plan.WorkflowID.ValueString(),
+		"action_id":   plan.ID.ValueString(),
+	})
+
+	if _, err := r.client.UpdateWorkflowAction(ctx, plan.WorkflowID.ValueString(), plan.ID.ValueString(), plan.expand()); err != nil {
+		resp.Diagnostics.AddError("Unable to update the workflow action", err.Error())
+		return
 	}
 
-	return nil
+	r.read(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceWorkflowActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*api.Client)
+func (r *workflowActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state workflowActionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !readOnlyFrameworkGuard(r.client, "squadcast_workflow_action", "delete", state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
 
 	tflog.Info(ctx, "Deleting workflow action", tf.M{
-		"worfklow_id": d.Get("workflow_id").(string),
-		"action_id":   d.Id(),
+		"workflow_id": state.WorkflowID.ValueString(),
+		"action_id":   state.ID.ValueString(),
 	})
 
-	workflowID := d.Get("workflow_id").(string)
+	if _, err := r.client.DeleteWorkflowAction(ctx, state.WorkflowID.ValueString(), state.ID.ValueString()); err != nil && !api.IsResourceNotFoundError(err) {
+		resp.Diagnostics.AddError("Unable to delete the workflow action", err.Error())
+	}
+}
 
-	_, err := client.DeleteWorkflowAction(ctx, workflowID, d.Id())
+// read refreshes the model from the API and returns false if the action no longer exists.
+func (r *workflowActionResource) read(ctx context.Context, m *workflowActionModel, diags *diag.Diagnostics) bool {
+	workflowAction, err := r.client.GetWorkflowActionById(ctx, m.WorkflowID.ValueString(), m.ID.ValueString())
 	if err != nil {
 		if api.IsResourceNotFoundError(err) {
-			d.SetId("")
-			return nil
+			return false
 		}
-		return diag.FromErr(err)
+		diags.AddError("Unable to read the workflow action", err.Error())
+		return false
 	}
 
-	return nil
+	m.flatten(workflowAction)
+	return true
 }
 
-func createWorkflowAction(d *schema.ResourceData) (*api.WorkflowAction, diag.Diagnostics) {
+// newWorkflowActionModel returns a model without settings, the blocks which are not set are empty
+// lists rather than null.
+func newWorkflowActionModel(id, workflowID, name types.String) workflowActionModel {
+	return workflowActionModel{
+		ID:                      id,
+		WorkflowID:              workflowID,
+		Name:                    name,
+		AddNote:                 []workflowActionAddNoteModel{},
+		AttachRunbooks:          []workflowActionAttachRunbooksModel{},
+		MarkSLOAffecting:        []workflowActionMarkSLOAffectingModel{},
+		AddCommunicationChannel: []workflowActionAddCommunicationChannelModel{},
+		UpdatePriority:          []workflowActionUpdatePriorityModel{},
+		HTTPCall:                []workflowActionHTTPCallModel{},
+		SendEmail:               []workflowActionSendEmailModel{},
+		TriggerWebhook:          []workflowActionTriggerWebhookModel{},
+		StatusPageUpdate:        []workflowActionStatusPageUpdateModel{},
+		JiraCreateTicket:        []workflowActionJiraCreateTicketModel{},
+		SlackCreateChannel:      []workflowActionSlackCreateChannelModel{},
+		SlackMessageChannel:     []workflowActionMessageChannelModel{},
+		SlackMessageUser:        []workflowActionMessageUserModel{},
+		MSTeamsMessageChannel:   []workflowActionMessageChannelModel{},
+		MSTeamsMessageUser:      []workflowActionMessageUserModel{},
+	}
+}
 
-	runbooks := tf.ListToSlice[string](d.Get("runbooks"))
-	channels := make([]api.Channels, 0)
-	headers := make([]api.Headers, 0)
-	componentAndImpact := make([]api.ComponentAndImpact, 0)
-	statusAndMessage := make([]api.StatusAndMessage, 0)
+// expand returns the request of the action. Only the block of the action type is set, the settings
+// of all types share the data of the request.
+func (m *workflowActionModel) expand() *api.WorkflowAction {
+	var data api.WorkflowActionData
 
-	if err := Decode(d.Get("channels"), &channels); err != nil {
-		return nil, diag.FromErr(err)
+	for _, b := range m.AddNote {
+		data.Note = b.Note.ValueString()
+	}
+	for _, b := range m.AttachRunbooks {
+		data.Runbooks = tf.ValueStrings(b.Runbooks)
+	}
+	for _, b := range m.MarkSLOAffecting {
+		data.SLO = int(b.SLO.ValueInt64())
+		data.SLIs = tf.ValueStrings(b.SLIs)
+	}
+	for _, b := range m.AddCommunicationChannel {
+		for _, channel := range b.Channels {
+			data.Channels = append(data.Channels, api.Channels{
+				ChannelType: channel.Type.ValueString(),
+				Link:        channel.Link.ValueString(),
+				DisplayText: channel.DisplayText.ValueString(),
+			})
+		}
+	}
+	for _, b := range m.UpdatePriority {
+		data.Priority = b.Priority.ValueString()
+	}
+	for _, b := range m.HTTPCall {
+		data.Method = b.Method.ValueString()
+		data.URL = b.URL.ValueString()
+		data.Body = b.Body.ValueString()
+		for _, header := range b.Headers {
+			data.Headers = append(data.Headers, api.Headers{
+				Key:   header.Key.ValueString(),
+				Value: header.Value.ValueString(),
+			})
+		}
+	}
+	for _, b := range m.SendEmail {
+		data.To = tf.ValueStrings(b.To)
+		data.Subject = b.Subject.ValueString()
+		data.Body = b.Body.ValueString()
+	}
+	for _, b := range m.TriggerWebhook {
+		data.WebhookID = b.WebhookID.ValueString()
+	}
+	for _, b := range m.StatusPageUpdate {
+		data.StatusPageID = int(b.StatusPageID.ValueInt64())
+		data.IssueTitle = b.IssueTitle.ValueString()
+		data.PageStatusID = int(b.PageStatusID.ValueInt64())
+		for _, c := range b.ComponentAndImpact {
+			data.ComponentAndImpact = append(data.ComponentAndImpact, api.ComponentAndImpact{
+				ComponentID:    int(c.ComponentID.ValueInt64()),
+				ImpactStatusID: int(c.ImpactStatusID.ValueInt64()),
+			})
+		}
+		for _, s := range b.StatusAndMessage {
+			data.StatusAndMessage = append(data.StatusAndMessage, api.StatusAndMessage{
+				StatusID: int(s.StatusID.ValueInt64()),
+				Messages: tf.ValueStrings(s.Messages),
+			})
+		}
+	}
+	for _, b := range m.JiraCreateTicket {
+		data.Account = b.Account.ValueString()
+		data.Project = b.Project.ValueString()
+		data.IssueType = b.IssueType.ValueString()
+		data.Title = b.Title.ValueString()
+		data.Description = b.Description.ValueString()
 	}
-	if err := Decode(d.Get("headers"), &headers); err != nil {
-		return nil, diag.FromErr(err)
+	for _, b := range m.SlackCreateChannel {
+		data.AutoName = b.AutoName.ValueBool()
+		data.ChannelName = b.ChannelName.ValueString()
 	}
-	if err := Decode(d.Get("component_and_impact"), &componentAndImpact); err != nil {
-		return nil, diag.FromErr(err)
+	for _, b := range append(m.SlackMessageChannel, m.MSTeamsMessageChannel...) {
+		data.ChannelID = b.ChannelID.ValueString()
+		data.Message = b.Message.ValueString()
 	}
-	if err := Decode(d.Get("status_and_message"), &statusAndMessage); err != nil {
-		return nil, diag.FromErr(err)
+	for _, b := range append(m.SlackMessageUser, m.MSTeamsMessageUser...) {
+		data.MemberID = b.MemberID.ValueString()
+		data.Message = b.Message.ValueString()
 	}
 
 	return &api.WorkflowAction{
-		Name: d.Get("name").(string),
-		Data: api.WorkflowActionData{
-			Note:               d.Get("note").(string),
-			SLO:                d.Get("slo").(int),
-			SLIs:               tf.ListToSlice[string](d.Get("slis")),
-			Priority:           d.Get("priority").(string),
-			Runbooks:           runbooks,
-			Channels:           channels,
-			Method:             d.Get("method").(string),
-			URL:                d.Get("url").(string),
-			Body:               d.Get("body").(string),
-			Headers:            headers,
-			To:                 tf.ListToSlice[string](d.Get("to")),
-			Subject:            d.Get("subject").(string),
-			WebhookID:          d.Get("webhook_id").(string),
-			StatusPageID:       d.Get("status_page_id").(int),
-			IssueTitle:         d.Get("issue_title").(string),
-			PageStatusID:       d.Get("page_status_id").(int),
+		Name: m.Name.ValueString(),
+		Data: data,
+	}
+}
+
+// flatten sets the block of the action type from the API response. Optional attributes which are not
+// set in the prior state stay null when the API returns a zero value.
+func (m *workflowActionModel) flatten(w *api.WorkflowActionRes) {
+	prior := *m
+	data := w.Data
+
+	workflowID := m.WorkflowID
+	if w.WorkflowID != 0 {
+		workflowID = types.StringValue(strconv.Itoa(w.WorkflowID))
+	}
+	*m = newWorkflowActionModel(types.StringValue(strconv.Itoa(w.ID)), workflowID, types.StringValue(w.Name))
+
+	switch w.Name {
+	case "sq_add_incident_note":
+		p := firstBlock(prior.AddNote)
+		m.AddNote = tf.List(workflowActionAddNoteModel{
+			Note: tf.StringValue(data.Note, p.Note),
+		})
+	case "sq_attach_runbooks":
+		p := firstBlock(prior.AttachRunbooks)
+		runbooks := make([]string, 0, len(data.Runbooks))
+		for _, runbook := range data.Runbooks {
+			runbooks = append(runbooks, runbook.ID)
+		}
+		m.AttachRunbooks = tf.List(workflowActionAttachRunbooksModel{
+			Runbooks: tf.StringSliceValue(runbooks, p.Runbooks),
+		})
+	case "sq_mark_incident_slo_affecting":
+		p := firstBlock(prior.MarkSLOAffecting)
+		// The response does not echo the SLO id, the configured value is kept.
+		m.MarkSLOAffecting = tf.List(workflowActionMarkSLOAffectingModel{
+			SLO:  p.SLO,
+			SLIs: tf.StringSliceValue(data.SLIs, p.SLIs),
+		})
+	case "sq_add_communication_channel":
+		channels := make([]workflowActionChannelModel, 0, len(data.Channels))
+		for _, channel := range data.Channels {
+			channels = append(channels, workflowActionChannelModel{
+				Type:        types.StringValue(channel.ChannelType),
+				Link:        types.StringValue(channel.Link),
+				DisplayText: types.StringValue(channel.DisplayText),
+			})
+		}
+		m.AddCommunicationChannel = tf.List(workflowActionAddCommunicationChannelModel{Channels: channels})
+	case "sq_update_incident_priority":
+		p := firstBlock(prior.UpdatePriority)
+		m.UpdatePriority = tf.List(workflowActionUpdatePriorityModel{
+			Priority: tf.StringValue(data.Priority, p.Priority),
+		})
+	case "sq_make_http_call":
+		p := firstBlock(prior.HTTPCall)
+		headers := make([]workflowActionHeaderModel, 0, len(data.Headers))
+		for _, header := range data.Headers {
+			headers = append(headers, workflowActionHeaderModel{
+				Key:   types.StringValue(header.Key),
+				Value: types.StringValue(header.Value),
+			})
+		}
+		m.HTTPCall = tf.List(workflowActionHTTPCallModel{
+			Method:  tf.StringValue(data.Method, p.Method),
+			URL:     tf.StringValue(data.URL, p.URL),
+			Headers: headers,
+			Body:    tf.StringValue(data.Body, p.Body),
+		})
+	case "sq_send_email":
+		p := firstBlock(prior.SendEmail)
+		m.SendEmail = tf.List(workflowActionSendEmailModel{
+			To:      tf.StringSliceValue(data.To, p.To),
+			Subject: tf.StringValue(data.Subject, p.Subject),
+			Body:    tf.StringValue(data.Body, p.Body),
+		})
+	case "sq_trigger_manual_webhook":
+		p := firstBlock(prior.TriggerWebhook)
+		m.TriggerWebhook = tf.List(workflowActionTriggerWebhookModel{
+			WebhookID: tf.StringValue(data.WebhookID, p.WebhookID),
+		})
+	case "sq_add_status_page_issue":
+		p := firstBlock(prior.StatusPageUpdate)
+		componentAndImpact := make([]workflowActionComponentAndImpactModel, 0, len(data.ComponentAndImpact))
+		for _, c := range data.ComponentAndImpact {
+			componentAndImpact = append(componentAndImpact, workflowActionComponentAndImpactModel{
+				ComponentID:    types.Int64Value(int64(c.ComponentID)),
+				ImpactStatusID: types.Int64Value(int64(c.ImpactStatusID)),
+			})
+		}
+		statusAndMessage := make([]workflowActionStatusAndMessageModel, 0, len(data.StatusAndMessage))
+		for i, s := range data.StatusAndMessage {
+			var priorMessages []types.String
+			if i < len(p.StatusAndMessage) {
+				priorMessages = p.StatusAndMessage[i].Messages
+			}
+			statusAndMessage = append(statusAndMessage, workflowActionStatusAndMessageModel{
+				StatusID: types.Int64Value(int64(s.StatusID)),
+				Messages: tf.StringSliceValue(s.Messages, priorMessages),
+			})
+		}
+		m.StatusPageUpdate = tf.List(workflowActionStatusPageUpdateModel{
+			StatusPageID:       tf.Int64Value(data.StatusPageID, p.StatusPageID),
+			IssueTitle:         tf.StringValue(data.IssueTitle, p.IssueTitle),
+			PageStatusID:       tf.Int64Value(data.PageStatusID, p.PageStatusID),
 			ComponentAndImpact: componentAndImpact,
 			StatusAndMessage:   statusAndMessage,
-			Account:            d.Get("account").(string),
-			Project:            d.Get("project").(string),
-			IssueType:          d.Get("issue_type").(string),
-			Title:              d.Get("title").(string),
-			Description:        d.Get("description").(string),
-			AutoName:           d.Get("auto_name").(bool),
-			ChannelName:        d.Get("channel_name").(string),
-			ChannelID:          d.Get("channel_id").(string),
-			Message:            d.Get("message").(string),
-			MemberID:           d.Get("member_id").(string),
-		},
-	}, nil
+		})
+	case "jira_create_ticket":
+		p := firstBlock(prior.JiraCreateTicket)
+		m.JiraCreateTicket = tf.List(workflowActionJiraCreateTicketModel{
+			Account:     tf.StringValue(data.Account, p.Account),
+			Project:     tf.StringValue(data.Project, p.Project),
+			IssueType:   tf.StringValue(data.IssueType, p.IssueType),
+			Title:       tf.StringValue(data.Title, p.Title),
+			Description: tf.StringValue(data.Description, p.Description),
+		})
+	case "slack_create_incident_channel":
+		p := firstBlock(prior.SlackCreateChannel)
+		m.SlackCreateChannel = tf.List(workflowActionSlackCreateChannelModel{
+			AutoName:    types.BoolValue(data.AutoName),
+			ChannelName: tf.StringValue(data.ChannelName, p.ChannelName),
+		})
+	case "slack_message_channel":
+		p := firstBlock(prior.SlackMessageChannel)
+		m.SlackMessageChannel = tf.List(flattenWorkflowActionMessageChannel(data, p))
+	case "slack_message_user":
+		p := firstBlock(prior.SlackMessageUser)
+		m.SlackMessageUser = tf.List(flattenWorkflowActionMessageUser(data, p))
+	case "msteams_message_channel":
+		p := firstBlock(prior.MSTeamsMessageChannel)
+		m.MSTeamsMessageChannel = tf.List(flattenWorkflowActionMessageChannel(data, p))
+	case "msteams_message_user":
+		p := firstBlock(prior.MSTeamsMessageUser)
+		m.MSTeamsMessageUser = tf.List(flattenWorkflowActionMessageUser(data, p))
+	}
+}
+
+func flattenWorkflowActionMessageChannel(data api.WorkflowActionDataRes, prior workflowActionMessageChannelModel) workflowActionMessageChannelModel {
+	return workflowActionMessageChannelModel{
+		ChannelID: tf.StringValue(data.ChannelID, prior.ChannelID),
+		Message:   tf.StringValue(data.Message, prior.Message),
+	}
+}
+
+func flattenWorkflowActionMessageUser(data api.WorkflowActionDataRes, prior workflowActionMessageUserModel) workflowActionMessageUserModel {
+	return workflowActionMessageUserModel{
+		MemberID: tf.StringValue(data.MemberID, prior.MemberID),
+		Message:  tf.StringValue(data.Message, prior.Message),
+	}
+}
+
+// firstBlock returns the block of a list which holds at most one, or a null block if it is empty.
+func firstBlock[T any](blocks []T) T {
+	var block T
+	if len(blocks) > 0 {
+		block = blocks[0]
+	}
+	return block
 }
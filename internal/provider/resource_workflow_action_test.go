@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -18,27 +19,62 @@ func TestAccResourceWorkflowAction(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckWorkflowActionDestroy,
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkflowActionDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceWorkflowActionConfig(workflowTitle),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
-					resource.TestCheckResourceAttr(resourceName, "note", "testing workflow action"),
+					resource.TestCheckResourceAttr(resourceName, "add_note.0.note", "testing workflow action"),
 				),
 			},
 			{
 				Config: testAccResourceWorkflowAction_update(workflowTitle),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
-					resource.TestCheckResourceAttr(resourceName, "note", "testing update workflow action"),
+					resource.TestCheckResourceAttr(resourceName, "add_note.0.note", "testing update workflow action"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccResourceWorkflowActionInvalidBlock(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "squadcast_workflow_action" "test_workflow_action" {
+					workflow_id = "1"
+					name = "sq_add_incident_note"
+					http_call {
+						method = "GET"
+						url = "https://example.com"
+					}
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`http_call can only be set when name is sq_make_http_call`),
+			},
+			{
+				Config: `
+				resource "squadcast_workflow_action" "test_workflow_action" {
+					workflow_id = "1"
+					name = "sq_send_email"
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`send_email must be set when name is sq_send_email`),
+			},
+		},
+	})
+}
+
 func testAccCheckWorkflowActionDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
@@ -62,7 +98,9 @@ func testAccResourceWorkflowActionConfig(workflowTitle string) string {
 	resource "squadcast_workflow_action" "test_workflow_action" {
 		workflow_id = squadcast_workflows.test_workflows.id
 		name = "sq_add_incident_note"
-		note = "testing workflow action"
+		add_note {
+			note = "testing workflow action"
+		}
 	}
 
 	resource "squadcast_workflows" "test_workflows" {
@@ -96,7 +134,9 @@ func testAccResourceWorkflowAction_update(workflowTitle string) string {
 	resource "squadcast_workflow_action" "test_workflow_action" {
 		workflow_id = squadcast_workflows.test_workflows.id
 		name = "sq_add_incident_note"
-		note = "testing update workflow action"
+		add_note {
+			note = "testing update workflow action"
+		}
 	}
 
 	resource "squadcast_workflows" "test_workflows" {
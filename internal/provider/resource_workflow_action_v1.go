@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// workflowActionModelV1 is the state of versions 0 and 1 of the schema, which held the settings of
+// all action types in flat attributes.
+type workflowActionModelV1 struct {
+	ID                 types.String                            `tfsdk:"id"`
+	WorkflowID         types.String                            `tfsdk:"workflow_id"`
+	Name               types.String                            `tfsdk:"name"`
+	Note               types.String                            `tfsdk:"note"`
+	Runbooks           []types.String                          `tfsdk:"runbooks"`
+	SLO                types.Int64                             `tfsdk:"slo"`
+	SLIs               []types.String                          `tfsdk:"slis"`
+	Channels           []workflowActionChannelModel            `tfsdk:"channels"`
+	Priority           types.String                            `tfsdk:"priority"`
+	Method             types.String                            `tfsdk:"method"`
+	URL                types.String                            `tfsdk:"url"`
+	Headers            []workflowActionHeaderModel             `tfsdk:"headers"`
+	Body               types.String                            `tfsdk:"body"`
+	To                 []types.String                          `tfsdk:"to"`
+	Subject            types.String                            `tfsdk:"subject"`
+	WebhookID          types.String                            `tfsdk:"webhook_id"`
+	StatusPageID       types.Int64                             `tfsdk:"status_page_id"`
+	IssueTitle         types.String                            `tfsdk:"issue_title"`
+	PageStatusID       types.Int64                             `tfsdk:"page_status_id"`
+	ComponentAndImpact []workflowActionComponentAndImpactModel `tfsdk:"component_and_impact"`
+	StatusAndMessage   []workflowActionStatusAndMessageModel   `tfsdk:"status_and_message"`
+	Account            types.String                            `tfsdk:"account"`
+	Project            types.String                            `tfsdk:"project"`
+	IssueType          types.String                            `tfsdk:"issue_type"`
+	Title              types.String                            `tfsdk:"title"`
+	Description        types.String                            `tfsdk:"description"`
+	AutoName           types.Bool                              `tfsdk:"auto_name"`
+	ChannelName        types.String                            `tfsdk:"channel_name"`
+	ChannelID          types.String                            `tfsdk:"channel_id"`
+	Message            types.String                            `tfsdk:"message"`
+	MemberID           types.String                            `tfsdk:"member_id"`
+}
+
+// workflowActionSchemaV1 is the schema of versions 0 and 1, only the types of the attributes matter
+// to read the prior state.
+func workflowActionSchemaV1() schema.Schema {
+	stringAttributes := []string{"note", "priority", "method", "url", "body", "subject", "webhook_id",
+		"issue_title", "account", "project", "issue_type", "title", "description", "channel_name", "channel_id",
+		"message", "member_id"}
+	attributes := map[string]schema.Attribute{
+		"id":             schema.StringAttribute{Computed: true},
+		"workflow_id":    schema.StringAttribute{Required: true},
+		"name":           schema.StringAttribute{Required: true},
+		"runbooks":       schema.ListAttribute{Optional: true, ElementType: types.StringType},
+		"slis":           schema.ListAttribute{Optional: true, ElementType: types.StringType},
+		"to":             schema.ListAttribute{Optional: true, ElementType: types.StringType},
+		"slo":            schema.Int64Attribute{Optional: true},
+		"status_page_id": schema.Int64Attribute{Optional: true},
+		"page_status_id": schema.Int64Attribute{Optional: true},
+		"auto_name":      schema.BoolAttribute{Optional: true},
+	}
+	for _, name := range stringAttributes {
+		attributes[name] = schema.StringAttribute{Optional: true}
+	}
+
+	nestedBlock := func(attributes map[string]schema.Attribute) schema.ListNestedBlock {
+		return schema.ListNestedBlock{NestedObject: schema.NestedBlockObject{Attributes: attributes}}
+	}
+
+	return schema.Schema{
+		Version:    1,
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"channels": nestedBlock(map[string]schema.Attribute{
+				"type":         schema.StringAttribute{Required: true},
+				"link":         schema.StringAttribute{Required: true},
+				"display_text": schema.StringAttribute{Required: true},
+			}),
+			"headers": nestedBlock(map[string]schema.Attribute{
+				"key":   schema.StringAttribute{Required: true},
+				"value": schema.StringAttribute{Required: true},
+			}),
+			"component_and_impact": nestedBlock(map[string]schema.Attribute{
+				"component_id":     schema.Int64Attribute{Required: true},
+				"impact_status_id": schema.Int64Attribute{Required: true},
+			}),
+			"status_and_message": nestedBlock(map[string]schema.Attribute{
+				"status_id": schema.Int64Attribute{Required: true},
+				"messages":  schema.ListAttribute{Optional: true, ElementType: types.StringType},
+			}),
+		},
+	}
+}
+
+// upgradeWorkflowActionStateV1 returns a state upgrader from the flat attributes of the prior
+// versions to the block of the action type. The SDKv2 implementation of version 0 stored unset
+// attributes as zero values, nullZero converts them to null first.
+func upgradeWorkflowActionStateV1(nullZero bool) resource.StateUpgrader {
+	priorSchema := workflowActionSchemaV1()
+
+	return resource.StateUpgrader{
+		PriorSchema: &priorSchema,
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			var prior workflowActionModelV1
+			resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if nullZero {
+				prior.nullZeroValues()
+			}
+			state := prior.upgrade()
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		},
+	}
+}
+
+func (m *workflowActionModelV1) nullZeroValues() {
+	for _, v := range []*types.String{&m.Note, &m.Priority, &m.Method, &m.URL, &m.Body, &m.Subject, &m.WebhookID,
+		&m.IssueTitle, &m.Account, &m.Project, &m.IssueType, &m.Title, &m.Description, &m.ChannelName,
+		&m.ChannelID, &m.Message, &m.MemberID} {
+		if v.ValueString() == "" {
+			*v = types.StringNull()
+		}
+	}
+	for _, v := range []*types.Int64{&m.SLO, &m.StatusPageID, &m.PageStatusID} {
+		if v.ValueInt64() == 0 {
+			*v = types.Int64Null()
+		}
+	}
+	for _, v := range []*[]types.String{&m.Runbooks, &m.SLIs, &m.To} {
+		if len(*v) == 0 {
+			*v = nil
+		}
+	}
+	for i := range m.StatusAndMessage {
+		if len(m.StatusAndMessage[i].Messages) == 0 {
+			m.StatusAndMessage[i].Messages = nil
+		}
+	}
+	if !m.AutoName.ValueBool() {
+		m.AutoName = types.BoolNull()
+	}
+}
+
+// upgrade moves the attributes of the action type into its block, the attributes of other action
+// types are dropped.
+func (m *workflowActionModelV1) upgrade() workflowActionModel {
+	state := newWorkflowActionModel(m.ID, m.WorkflowID, m.Name)
+
+	switch m.Name.ValueString() {
+	case "sq_add_incident_note":
+		state.AddNote = []workflowActionAddNoteModel{{Note: m.Note}}
+	case "sq_attach_runbooks":
+		state.AttachRunbooks = []workflowActionAttachRunbooksModel{{Runbooks: m.Runbooks}}
+	case "sq_mark_incident_slo_affecting":
+		state.MarkSLOAffecting = []workflowActionMarkSLOAffectingModel{{SLO: m.SLO, SLIs: m.SLIs}}
+	case "sq_add_communication_channel":
+		state.AddCommunicationChannel = []workflowActionAddCommunicationChannelModel{{Channels: emptyIfNil(m.Channels)}}
+	case "sq_update_incident_priority":
+		state.UpdatePriority = []workflowActionUpdatePriorityModel{{Priority: m.Priority}}
+	case "sq_make_http_call":
+		state.HTTPCall = []workflowActionHTTPCallModel{{Method: m.Method, URL: m.URL, Body: m.Body, Headers: emptyIfNil(m.Headers)}}
+	case "sq_send_email":
+		state.SendEmail = []workflowActionSendEmailModel{{To: m.To, Subject: m.Subject, Body: m.Body}}
+	case "sq_trigger_manual_webhook":
+		state.TriggerWebhook = []workflowActionTriggerWebhookModel{{WebhookID: m.WebhookID}}
+	case "sq_add_status_page_issue":
+		state.StatusPageUpdate = []workflowActionStatusPageUpdateModel{{
+			StatusPageID:       m.StatusPageID,
+			IssueTitle:         m.IssueTitle,
+			PageStatusID:       m.PageStatusID,
+			ComponentAndImpact: emptyIfNil(m.ComponentAndImpact),
+			StatusAndMessage:   emptyIfNil(m.StatusAndMessage),
+		}}
+	case "jira_create_ticket":
+		state.JiraCreateTicket = []workflowActionJiraCreateTicketModel{{
+			Account:     m.Account,
+			Project:     m.Project,
+			IssueType:   m.IssueType,
+			Title:       m.Title,
+			Description: m.Description,
+		}}
+	case "slack_create_incident_channel":
+		state.SlackCreateChannel = []workflowActionSlackCreateChannelModel{{
+			AutoName:    types.BoolValue(m.AutoName.ValueBool()),
+			ChannelName: m.ChannelName,
+		}}
+	case "slack_message_channel":
+		state.SlackMessageChannel = []workflowActionMessageChannelModel{{ChannelID: m.ChannelID, Message: m.Message}}
+	case "slack_message_user":
+		state.SlackMessageUser = []workflowActionMessageUserModel{{MemberID: m.MemberID, Message: m.Message}}
+	case "msteams_message_channel":
+		state.MSTeamsMessageChannel = []workflowActionMessageChannelModel{{ChannelID: m.ChannelID, Message: m.Message}}
+	case "msteams_message_user":
+		state.MSTeamsMessageUser = []workflowActionMessageUserModel{{MemberID: m.MemberID, Message: m.Message}}
+	}
+
+	return state
+}
+
+// emptyIfNil returns an empty slice instead of nil, nested blocks which are not configured are empty
+// lists rather than null.
+func emptyIfNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -12,12 +14,19 @@ import (
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
+// defaultWorkflowTagColor is the color of the default tags of the provider added to workflows.
+const defaultWorkflowTagColor = "#000000"
+
 func resourceWorkflow() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceWorkflowsCreate,
 		ReadContext:   resourceWorkflowsRead,
 		UpdateContext: resourceWorkflowsUpdate,
 		DeleteContext: resourceWorkflowsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customizeDiffTagsAll,
 		Schema: map[string]*schema.Schema{
 			"owner_id": {
 				Type:         schema.TypeString,
@@ -70,49 +79,10 @@ func resourceWorkflow() *schema.Resource {
 							Optional:    true,
 						},
 						"filters": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"condition": {
-										Type:        schema.TypeString,
-										Description: "Condition to be applied on the filters (and / or)",
-										Optional:    true,
-									},
-									"type": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"key": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"value": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"filters": {
-										Type:     schema.TypeList,
-										Optional: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"type": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"key": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"value": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-											},
-										},
-									},
-								},
-							},
+							Type:        schema.TypeList,
+							Description: fmt.Sprintf("The filters, which may be groups of filters nested up to %d levels", api.WorkflowFilterMaxDepth),
+							Optional:    true,
+							Elem:        workflowFilterResource(api.WorkflowFilterMaxDepth),
 						},
 					},
 				},
@@ -138,6 +108,7 @@ func resourceWorkflow() *schema.Resource {
 					},
 				},
 			},
+			"tags_all": tagsAllSchema(),
 			"entity_owner": {
 				Type:        schema.TypeList,
 				Description: "The entity owner of the workflow",
@@ -161,6 +132,56 @@ func resourceWorkflow() *schema.Resource {
 	}
 }
 
+// workflowFilterResource returns the schema of a filter, either a filter on a key or a group of
+// filters joined by its condition. Groups nest down to depth levels.
+func workflowFilterResource(depth int) *schema.Resource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"condition": {
+				Type:        schema.TypeString,
+				Description: "Condition to be applied on the filters (and / or)",
+				Optional:    true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+	if depth > 1 {
+		r.Schema["filters"] = &schema.Schema{
+			Type:        schema.TypeList,
+			Description: "The filters of the group",
+			Optional:    true,
+			Elem:        workflowFilterResource(depth - 1),
+		}
+	}
+
+	return r
+}
+
+// validateWorkflowFilterConditions checks that every group of more than one filter has a condition.
+func validateWorkflowFilterConditions(condition string, filters []*api.FilterGroup) error {
+	if len(filters) > 1 && condition == "" {
+		return errors.New("condition cannot be empty when more than one filter is being added")
+	}
+	for _, filter := range filters {
+		if err := validateWorkflowFilterConditions(filter.Condition, filter.Filters); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceWorkflowsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client := meta.(*api.Client)
@@ -192,11 +213,13 @@ func resourceWorkflowsCreate(ctx context.Context, d *schema.ResourceData, meta i
 		workflowReq.Filters = filters[0]
 	}
 
-	if workflowReq.Filters != nil && len(workflowReq.Filters.Filters) > 1 && workflowReq.Filters.Condition == "" {
-		return diag.Errorf("condition cannot be empty when more than one filter is being added")
+	if workflowReq.Filters != nil {
+		if err := validateWorkflowFilterConditions(workflowReq.Filters.Condition, workflowReq.Filters.Filters); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	mtags := d.Get("tags").([]any)
+	mtags := mergeDefaultTagList(client, d.Get("tags").([]any), map[string]any{"color": defaultWorkflowTagColor})
 
 	if len(mtags) > 0 {
 		var tags []*api.WorkflowTag
@@ -230,10 +253,15 @@ func resourceWorkflowsRead(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.FromErr(err)
 	}
 
+	tags := d.Get("tags")
 	if err = tf.EncodeAndSet(workflow, d); err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err = setTagsAll(d, client, tags); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -267,11 +295,13 @@ func resourceWorkflowsUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		workflowReq.Filters = filters[0]
 	}
 
-	if workflowReq.Filters != nil && len(workflowReq.Filters.Filters) > 1 && workflowReq.Filters.Condition == "" {
-		return diag.Errorf("condition cannot be empty when more than one filter is being added")
+	if workflowReq.Filters != nil {
+		if err := validateWorkflowFilterConditions(workflowReq.Filters.Condition, workflowReq.Filters.Filters); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	mtags := d.Get("tags").([]any)
+	mtags := mergeDefaultTagList(client, d.Get("tags").([]any), map[string]any{"color": defaultWorkflowTagColor})
 
 	if len(mtags) > 0 {
 		var tags []*api.WorkflowTag
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -13,20 +14,20 @@ import (
 
 func TestAccResourceWorkflows(t *testing.T) {
 	workflowTitle := acctest.RandomWithPrefix("test-workflow")
-	resourceName := "squadcast_workflows.test_workflows"
+	resourceName := "squadcast_workflow.test_workflows"
 	resource.UnitTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckWorkflowsDestroy,
+		ProtoV5ProviderFactories: protoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkflowsDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceWorkflowsConfig(workflowTitle),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
 					resource.TestCheckResourceAttr(resourceName, "title", workflowTitle),
-					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Test workflow description"),
 				),
 			},
 			{
@@ -34,18 +35,75 @@ func TestAccResourceWorkflows(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
 					resource.TestCheckResourceAttr(resourceName, "title", workflowTitle),
-					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Test workflow description"),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.filters.1.condition", "or"),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.filters.1.filters.1.value", "staging"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func TestValidateWorkflowFilterConditions(t *testing.T) {
+	filter := func(value string) *api.FilterGroup {
+		return &api.FilterGroup{Type: "priority_is", Value: value}
+	}
+
+	cases := map[string]struct {
+		condition string
+		filters   []*api.FilterGroup
+		wantErr   bool
+	}{
+		"single filter":             {filters: []*api.FilterGroup{filter("P1")}},
+		"several filters":           {condition: "and", filters: []*api.FilterGroup{filter("P1"), filter("P2")}},
+		"several without condition": {filters: []*api.FilterGroup{filter("P1"), filter("P2")}, wantErr: true},
+		"nested group": {
+			condition: "and",
+			filters:   []*api.FilterGroup{filter("P1"), {Condition: "or", Filters: []*api.FilterGroup{filter("P2"), filter("P3")}}},
+		},
+		"nested group without condition": {
+			condition: "and",
+			filters:   []*api.FilterGroup{filter("P1"), {Filters: []*api.FilterGroup{filter("P2"), filter("P3")}}},
+			wantErr:   true,
+		},
+		"nested single filter without condition": {
+			filters: []*api.FilterGroup{{Filters: []*api.FilterGroup{filter("P1")}}},
+		},
+		"deeply nested group without condition": {
+			filters: []*api.FilterGroup{{Condition: "or", Filters: []*api.FilterGroup{
+				filter("P1"),
+				{Filters: []*api.FilterGroup{filter("P2"), filter("P3"), filter("P4")}},
+			}}},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateWorkflowFilterConditions(tc.condition, tc.filters)
+			if tc.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "condition cannot be empty") {
+					t.Fatalf("got error %v, want the condition to be required", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
 func testAccCheckWorkflowsDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "squadcast_workflows" {
+		if rs.Type != "squadcast_workflow" {
 			continue
 		}
 
@@ -60,7 +118,7 @@ func testAccCheckWorkflowsDestroy(s *terraform.State) error {
 
 func testAccResourceWorkflowsConfig(workflowTitle string) string {
 	return fmt.Sprintf(`
-	resource "squadcast_workflows" "test_workflows" {
+	resource "squadcast_workflow" "test_workflows" {
 		title = "%s"
 		description = "Test workflow description"
 		owner_id = "63bfabae865e9c93cd31756e"
@@ -87,17 +145,31 @@ func testAccResourceWorkflowsConfig(workflowTitle string) string {
 
 func testAccResourceWorkflows_update(workflowTitle string) string {
 	return fmt.Sprintf(`
-	resource "squadcast_workflows" "test_workflows" {
+	resource "squadcast_workflow" "test_workflows" {
 		title = "%s"
 		description = "Test workflow description"
 		owner_id = "63bfabae865e9c93cd31756e"
 		enabled = true
 		trigger = "incident_triggered"
 		filters {
+			condition = "and"
 			filters {
 				type = "priority_is"
 				value = "P1"
 			}
+			filters {
+				condition = "or"
+				filters {
+					type = "tag_is"
+					key = "env"
+					value = "prod"
+				}
+				filters {
+					type = "tag_is"
+					key = "env"
+					value = "staging"
+				}
+			}
 		}
 		entity_owner {
 			type = "user" 
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+)
+
+// ProtoV5ProviderServerFactory returns a factory for the provider server which serves the SDKv2 and
+// the terraform-plugin-framework resources side by side.
+func ProtoV5ProviderServerFactory(ctx context.Context, version string) (func() tfprotov5.ProviderServer, error) {
+	sdkProvider := New(version)()
+
+	servers := []func() tfprotov5.ProviderServer{
+		// The SDKv2 provider has to stay first, as it configures the client shared with the framework provider.
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(NewFramework(version, sdkProvider)()),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, servers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
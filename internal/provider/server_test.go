@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProtoV5ProviderServer(t *testing.T) {
+	ctx := context.Background()
+
+	serverFactory, err := ProtoV5ProviderServerFactory(ctx, "dev")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	resp, err := serverFactory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	for _, name := range []string{"squadcast_service", "squadcast_schedule_rotation_v2", "squadcast_workflow_action"} {
+		if _, ok := resp.ResourceSchemas[name]; !ok {
+			t.Errorf("resource %s is not served", name)
+		}
+	}
+	for _, name := range []string{"squadcast_access_token", "squadcast_service_api_key"} {
+		if _, ok := resp.EphemeralResourceSchemas[name]; !ok {
+			t.Errorf("ephemeral resource %s is not served", name)
+		}
+	}
+	for _, name := range []string{"squadcast_service", "squadcast_escalation_policy", "squadcast_squad", "squadcast_schedule_v2", "squadcast_runbook", "squadcast_webform"} {
+		if _, ok := resp.ListResourceSchemas[name]; !ok {
+			t.Errorf("list resource %s is not served", name)
+		}
+		if _, ok := resp.ResourceSchemas[name]; !ok {
+			t.Errorf("resource %s is not served", name)
+		}
+	}
+}
+
+// TestProtoV5ProviderServerUpgradeSDKState verifies that state written by the former SDKv2
+// implementations of the migrated resources, and by prior schema versions, can still be read.
+func TestProtoV5ProviderServerUpgradeSDKState(t *testing.T) {
+	ctx := context.Background()
+
+	serverFactory, err := ProtoV5ProviderServerFactory(ctx, "dev")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	server := serverFactory()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	cases := map[string]struct {
+		typeName  string
+		version   int64
+		state     string
+		wantNull  []string
+		wantValue map[string]tftypes.Value
+		// wantBlock are the attributes expected in the first element of blocks.
+		wantBlock map[string]map[string]tftypes.Value
+	}{
+		"squadcast_schedule_rotation_v2": {
+			typeName: "squadcast_schedule_rotation_v2",
+			state: `{
+				"id": "1234",
+				"schedule_id": 100,
+				"name": "rotation",
+				"participant_groups": [{"participants": [{"type": "team", "id": "613611c1eb22db455cfa789f"}]}],
+				"start_date": "2023-06-09T00:00:00Z",
+				"period": "weekly",
+				"shift_timeslots": [{"start_hour": 10, "start_minute": 30, "duration": 720, "day_of_week": ""}],
+				"custom_period_frequency": 0,
+				"custom_period_unit": "",
+				"change_participants_frequency": 1,
+				"change_participants_unit": "rotation",
+				"end_date": "",
+				"ends_after_iterations": 2
+			}`,
+			wantValue: map[string]tftypes.Value{
+				"id":          tftypes.NewValue(tftypes.String, "1234"),
+				"schedule_id": tftypes.NewValue(tftypes.Number, 100),
+			},
+		},
+		"squadcast_workflow_action": {
+			typeName: "squadcast_workflow_action",
+			state: `{
+				"id": "42",
+				"workflow_id": "7",
+				"name": "sq_add_incident_note",
+				"note": "note",
+				"runbooks": [],
+				"slo": 0,
+				"slis": [],
+				"channels": [],
+				"priority": "",
+				"method": "",
+				"url": "",
+				"headers": [],
+				"body": "",
+				"to": [],
+				"subject": "",
+				"webhook_id": "",
+				"status_page_id": 0,
+				"issue_title": "",
+				"page_status_id": 0,
+				"component_and_impact": [],
+				"status_and_message": [],
+				"account": "",
+				"project": "",
+				"issue_type": "",
+				"title": "",
+				"description": "",
+				"auto_name": false,
+				"channel_name": "",
+				"channel_id": "",
+				"message": "",
+				"member_id": ""
+			}`,
+			wantValue: map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "sq_add_incident_note"),
+			},
+			wantBlock: map[string]map[string]tftypes.Value{
+				"add_note": {
+					"note": tftypes.NewValue(tftypes.String, "note"),
+				},
+			},
+		},
+		"squadcast_workflow_action v1": {
+			typeName: "squadcast_workflow_action",
+			version:  1,
+			state: `{
+				"id": "42",
+				"workflow_id": "7",
+				"name": "sq_make_http_call",
+				"note": null,
+				"runbooks": null,
+				"slo": null,
+				"slis": null,
+				"channels": [],
+				"priority": null,
+				"method": "POST",
+				"url": "https://example.com",
+				"headers": [{"key": "content-type", "value": "application/json"}],
+				"body": null,
+				"to": null,
+				"subject": null,
+				"webhook_id": null,
+				"status_page_id": null,
+				"issue_title": null,
+				"page_status_id": null,
+				"component_and_impact": [],
+				"status_and_message": [],
+				"account": null,
+				"project": null,
+				"issue_type": null,
+				"title": null,
+				"description": null,
+				"auto_name": null,
+				"channel_name": null,
+				"channel_id": null,
+				"message": null,
+				"member_id": null
+			}`,
+			wantBlock: map[string]map[string]tftypes.Value{
+				"http_call": {
+					"method": tftypes.NewValue(tftypes.String, "POST"),
+					"url":    tftypes.NewValue(tftypes.String, "https://example.com"),
+					"body":   tftypes.NewValue(tftypes.String, nil),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+				TypeName: tc.typeName,
+				Version:  tc.version,
+				RawState: &tfprotov5.RawState{JSON: []byte(tc.state)},
+			})
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			for _, d := range resp.Diagnostics {
+				t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+			}
+
+			state, err := resp.UpgradedState.Unmarshal(schemaResp.ResourceSchemas[tc.typeName].ValueType())
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			var attributes map[string]tftypes.Value
+			if err := state.As(&attributes); err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			for _, name := range tc.wantNull {
+				if !attributes[name].IsNull() {
+					t.Errorf("expected %s to be null, got %s", name, attributes[name])
+				}
+			}
+			for name, want := range tc.wantValue {
+				if !attributes[name].Equal(want) {
+					t.Errorf("expected %s to be %s, got %s", name, want, attributes[name])
+				}
+			}
+			for block, wantAttributes := range tc.wantBlock {
+				var elements []tftypes.Value
+				if err := attributes[block].As(&elements); err != nil || len(elements) != 1 {
+					t.Fatalf("expected one %s block, got %s", block, attributes[block])
+				}
+				var blockAttributes map[string]tftypes.Value
+				if err := elements[0].As(&blockAttributes); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+				for name, want := range wantAttributes {
+					if !blockAttributes[name].Equal(want) {
+						t.Errorf("expected %s.0.%s to be %s, got %s", block, name, want, blockAttributes[name])
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+var errTeamIDRequired = errors.New("team_id is not set and the provider has no default_team_id")
+
+// customizeDiffDefaultTeamID plans the default team of the provider as team_id when a new resource does
+// not set it. Resources which already have a team_id keep it.
+func customizeDiffDefaultTeamID(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	config := d.GetRawConfig()
+	if !config.IsKnown() || config.IsNull() || !config.GetAttr("team_id").IsNull() {
+		return nil
+	}
+	if d.Get("team_id").(string) != "" {
+		return nil
+	}
+
+	client := meta.(*api.Client)
+	if client.DefaultTeamID == "" {
+		return errTeamIDRequired
+	}
+
+	return d.SetNew("team_id", client.DefaultTeamID)
+}
+
+// dataSourceTeamID returns the team_id of a data source, defaulting to the default team of the provider.
+func dataSourceTeamID(d *schema.ResourceData, meta any) (string, error) {
+	if teamID, ok := d.GetOk("team_id"); ok {
+		return teamID.(string), nil
+	}
+
+	client := meta.(*api.Client)
+	if client.DefaultTeamID == "" {
+		return "", errTeamIDRequired
+	}
+
+	return client.DefaultTeamID, d.Set("team_id", client.DefaultTeamID)
+}
+
+// frameworkTeamID returns the team_id of a framework resource, defaulting to the default team of the provider.
+func frameworkTeamID(teamID types.String, client *api.Client) (string, error) {
+	if !teamID.IsNull() {
+		return teamID.ValueString(), nil
+	}
+	if client.DefaultTeamID == "" {
+		return "", errTeamIDRequired
+	}
+
+	return client.DefaultTeamID, nil
+}
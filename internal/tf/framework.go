@@ -0,0 +1,53 @@
+package tf
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The following helpers convert API values for terraform-plugin-framework resources. The API does not
+// distinguish between a zero value and an unset field, so a zero value is kept null when the prior value
+// was null, to avoid a diff against configurations which do not set the attribute.
+
+func StringValue(v string, prior types.String) types.String {
+	if v == "" && prior.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
+
+func Int64Value(v int, prior types.Int64) types.Int64 {
+	if v == 0 && prior.IsNull() {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(v))
+}
+
+func BoolValue(v bool, prior types.Bool) types.Bool {
+	if !v && prior.IsNull() {
+		return types.BoolNull()
+	}
+	return types.BoolValue(v)
+}
+
+func StringSliceValue(v []string, prior []types.String) []types.String {
+	if len(v) == 0 && prior == nil {
+		return nil
+	}
+	values := make([]types.String, len(v))
+	for i, s := range v {
+		values[i] = types.StringValue(s)
+	}
+	return values
+}
+
+// ValueStrings converts a list of framework strings to a slice, skipping null and unknown values.
+func ValueStrings(values []types.String) []string {
+	s := make([]string, 0, len(values))
+	for _, v := range values {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		s = append(s, v.ValueString())
+	}
+	return s
+}
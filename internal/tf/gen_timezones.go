@@ -0,0 +1,51 @@
+//go:build ignore
+
+// gen_timezones writes timezones.go, the names of the time zones in the zoneinfo.zip of the Go
+// installation, which is the tz database embedded by time/tzdata.
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+)
+
+func main() {
+	r, err := zip.OpenReader(filepath.Join(runtime.GOROOT(), "lib", "time", "zoneinfo.zip"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Close()
+
+	var names []string
+	for _, f := range r.File {
+		if !f.FileInfo().IsDir() {
+			names = append(names, f.Name)
+		}
+	}
+	sort.Strings(names)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen_timezones.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package tf\n\n")
+	fmt.Fprintf(&b, "// timezones are the names of the time zones of the tz database.\n")
+	fmt.Fprintf(&b, "var timezones = []string{\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "\t%q,\n", name)
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("timezones.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,120 @@
+package tf
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// Embed the tz database, so that time zones are validated the same way on hosts without one.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+//go:generate go run gen_timezones.go
+
+// ValidateTimezone validates the name of an IANA time zone, e.g. "Asia/Kolkata". Names which only
+// differ in case from a time zone are valid, the closest time zone is suggested otherwise.
+func ValidateTimezone(v any, k string) (warns []string, errs []error) {
+	name, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if err := CheckTimezone(name); err != nil {
+		return nil, []error{fmt.Errorf("%s: %w", k, err)}
+	}
+
+	return nil, nil
+}
+
+// SuppressEquivalentTimezone suppresses the diff of time zone names which only differ in case.
+func SuppressEquivalentTimezone(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+// CheckTimezone returns an error if name is not the name of a time zone, suggesting the closest one.
+func CheckTimezone(name string) error {
+	if _, ok := lookupTimezone(name); ok {
+		return nil
+	}
+
+	err := fmt.Errorf("%q is not a valid IANA time zone, e.g. \"Asia/Kolkata\"", name)
+	if suggestion := closestTimezone(name); suggestion != "" {
+		err = fmt.Errorf("%q is not a valid IANA time zone, did you mean %q?", name, suggestion)
+	}
+
+	return err
+}
+
+// CanonicalTimezone returns the name of a time zone as in the tz database, e.g. "Asia/Kolkata" for
+// "asia/kolkata". Names which are not time zones are returned as is.
+func CanonicalTimezone(name string) string {
+	if canonical, ok := lookupTimezone(name); ok {
+		return canonical
+	}
+
+	return name
+}
+
+// lookupTimezone returns the canonical name of a time zone. Time zones missing from timezones,
+// e.g. added to the tz database since it was generated, are looked up with time.LoadLocation.
+func lookupTimezone(name string) (string, bool) {
+	if name == "" || name == "Local" {
+		return "", false
+	}
+
+	for _, tz := range timezones {
+		if strings.EqualFold(tz, name) {
+			return tz, true
+		}
+	}
+
+	if _, err := time.LoadLocation(name); err == nil {
+		return name, true
+	}
+
+	return "", false
+}
+
+// closestTimezone returns the time zone with the smallest edit distance to name, ignoring case, or
+// an empty string if none of them is close.
+func closestTimezone(name string) string {
+	if name == "" {
+		return ""
+	}
+	name = strings.ToLower(name)
+	maxDistance := max(1, len(name)/4)
+
+	closest, closestDistance := "", maxDistance+1
+	for _, tz := range timezones {
+		if distance := levenshtein(name, strings.ToLower(tz)); distance < closestDistance {
+			closest, closestDistance = tz, distance
+		}
+	}
+
+	return closest
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
@@ -0,0 +1,67 @@
+package tf
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckTimezone(t *testing.T) {
+	cases := map[string]struct {
+		name    string
+		wantErr string
+	}{
+		"canonical":           {name: "Asia/Kolkata"},
+		"case insensitive":    {name: "asia/kolkata"},
+		"utc":                 {name: "UTC"},
+		"alias":               {name: "Asia/Calcutta"},
+		"typo":                {name: "Asia/Kolkatta", wantErr: `did you mean "Asia/Kolkata"?`},
+		"unknown":             {name: "Nowhere/Special", wantErr: `is not a valid IANA time zone, e.g. "Asia/Kolkata"`},
+		"empty":               {name: "", wantErr: "is not a valid IANA time zone"},
+		"local is not a zone": {name: "Local", wantErr: "is not a valid IANA time zone"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := CheckTimezone(tc.name)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("got error %v, want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestCanonicalTimezone(t *testing.T) {
+	for name, want := range map[string]string{
+		"Asia/Kolkata":                   "Asia/Kolkata",
+		"asia/kolkata":                   "Asia/Kolkata",
+		"AMERICA/ARGENTINA/BUENOS_AIRES": "America/Argentina/Buenos_Aires",
+		"utc":                            "UTC",
+		"Asia/Calcutta":                  "Asia/Calcutta",
+		"Nowhere/Special":                "Nowhere/Special",
+		"":                               "",
+	} {
+		if got := CanonicalTimezone(name); got != want {
+			t.Errorf("CanonicalTimezone(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestClosestTimezone(t *testing.T) {
+	for name, want := range map[string]string{
+		"Asia/Kolkatta":   "Asia/Kolkata",
+		"europe/londn":    "Europe/London",
+		"Amerika/Denver":  "America/Denver",
+		"Nowhere/Special": "",
+		"":                "",
+	} {
+		if got := closestTimezone(name); got != want {
+			t.Errorf("closestTimezone(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
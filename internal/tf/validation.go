@@ -1,5 +1,14 @@
 package tf
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 var ValidateObjectID = validation.StringLenBetween(24, 24)
+
+// ObjectIDValidator is the terraform-plugin-framework counterpart of ValidateObjectID.
+func ObjectIDValidator() validator.String {
+	return stringvalidator.LengthBetween(24, 24)
+}